@@ -0,0 +1,8 @@
+# Look up a GCS log source managed elsewhere, by integration_label or by id.
+data "panther_gcssource" "audit" {
+  integration_label = "gcp-audit-logs"
+}
+
+output "audit_bucket" {
+  value = data.panther_gcssource.audit.gcs_bucket
+}
@@ -0,0 +1,8 @@
+# Look up an HTTP log source managed elsewhere, by integration_label or by id.
+data "panther_httpsource" "webhooks" {
+  integration_label = "example-http-source"
+}
+
+output "webhooks_log_types" {
+  value = data.panther_httpsource.webhooks.log_types
+}
@@ -0,0 +1,26 @@
+# Read the live state of a log source's alarms. The check block surfaces a
+# warning on every plan and apply while the source has stopped sending data.
+data "panther_httpsource" "webhooks" {
+  integration_label = "example-http-source"
+}
+
+data "panther_log_source_alarm" "permissions" {
+  source_id = data.panther_httpsource.webhooks.id
+  type      = "SOURCE_PERMISSIONS_CHECKS"
+}
+
+check "webhooks_healthy" {
+  data "panther_log_source_alarm" "no_data" {
+    source_id = data.panther_httpsource.webhooks.id
+    type      = "SOURCE_NO_DATA"
+  }
+
+  assert {
+    condition     = data.panther_log_source_alarm.no_data.state != "ALARM"
+    error_message = "No data received within ${data.panther_log_source_alarm.no_data.minutes_threshold} minutes."
+  }
+}
+
+output "permissions_state" {
+  value = data.panther_log_source_alarm.permissions.state
+}
@@ -0,0 +1,23 @@
+# Attach a no-data alarm to every production log source, including ones
+# created outside Terraform.
+data "panther_log_sources" "prod" {
+  label_regex = "^prod-"
+}
+
+resource "panther_log_source_alarm" "no_data" {
+  for_each = { for source in data.panther_log_sources.prod.log_sources : source.id => source }
+
+  source_id         = each.key
+  type              = "SOURCE_NO_DATA"
+  minutes_threshold = 60
+}
+
+# Every HTTP source ingesting CloudTrail.
+data "panther_log_sources" "cloudtrail_http" {
+  type     = "http"
+  log_type = "AWS.CloudTrail"
+}
+
+output "cloudtrail_http_labels" {
+  value = data.panther_log_sources.cloudtrail_http.log_sources[*].label
+}
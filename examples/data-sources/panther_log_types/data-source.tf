@@ -0,0 +1,26 @@
+data "panther_log_types" "custom" {
+  managed = false
+}
+
+# Fail the plan, rather than the apply, if the firewall schema hasn't been deployed
+# (or has been disabled) in this instance yet.
+resource "panther_s3_source" "firewall" {
+  aws_account_id                               = "123456789012"
+  name                                         = "firewall-logs"
+  log_processing_role_arn                      = "arn:aws:iam::123456789012:role/PantherLogProcessingRole-firewall"
+  log_stream_type                              = "Lines"
+  panther_managed_bucket_notifications_enabled = true
+  bucket_name                                  = "firewall-logs-bucket"
+  prefix_log_types = [{
+    prefix            = ""
+    excluded_prefixes = []
+    log_types         = ["Custom.Firewall"]
+  }]
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.panther_log_types.custom.names, "Custom.Firewall")
+      error_message = "Deploy the Custom.Firewall schema before creating this source."
+    }
+  }
+}
@@ -0,0 +1,8 @@
+# Look up a Pub/Sub log source managed elsewhere, by id or by integration_label.
+data "panther_pubsubsource" "audit" {
+  id = "00000000-0000-0000-0000-000000000000"
+}
+
+output "audit_subscription" {
+  value = data.panther_pubsubsource.audit.subscription_id
+}
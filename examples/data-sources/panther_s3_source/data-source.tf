@@ -0,0 +1,10 @@
+# Look up an S3 log source managed elsewhere, by name or by id.
+data "panther_s3_source" "cloudtrail" {
+  name = "org-cloudtrail"
+}
+
+resource "panther_log_source_alarm" "cloudtrail" {
+  source_id         = data.panther_s3_source.cloudtrail.id
+  type              = "SOURCE_NO_DATA"
+  minutes_threshold = 60
+}
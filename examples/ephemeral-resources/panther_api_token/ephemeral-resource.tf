@@ -0,0 +1,13 @@
+# A token that can only manage rules, created for this run and deleted when it ends.
+# Here it configures a second provider, so the detections module can't touch anything
+# else. expires_at bounds its life if Terraform exits before deleting it.
+ephemeral "panther_api_token" "detections" {
+  name        = "detections-deploy"
+  permissions = ["RuleRead", "RuleModify"]
+  expires_at  = "2030-01-01T00:00:00Z"
+}
+
+provider "panther" {
+  alias = "detections"
+  token = ephemeral.panther_api_token.detections.value
+}
@@ -0,0 +1,3 @@
+# Import an existing API token by its ID. The token's secret can't be read back, so
+# value is null after import.
+terraform import panther_api_token.example 9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d
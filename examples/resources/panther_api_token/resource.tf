@@ -0,0 +1,13 @@
+# A token for a CI pipeline that uploads detections, usable only from the CI
+# network. The secret is only available in the apply that creates the token.
+resource "panther_api_token" "ci" {
+  name                = "detections-ci"
+  permissions         = ["RuleModify", "PolicyModify", "BulkUpload"]
+  allowed_cidr_blocks = ["203.0.113.0/24"]
+  expires_at          = "2027-01-01T00:00:00Z"
+}
+
+output "ci_token" {
+  value     = panther_api_token.ci.value
+  sensitive = true
+}
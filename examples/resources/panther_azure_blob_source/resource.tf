@@ -0,0 +1,29 @@
+# Manage an Azure Blob Storage Log Source integration in Panther.
+# Panther reads new blobs from the container using an Entra ID app registration
+# that has the Storage Blob Data Reader role on the storage account.
+variable "azure_client_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "panther_azure_blob_source" "example" {
+  integration_label    = "my-azure-blob-logs"
+  tenant_id            = "00000000-0000-0000-0000-000000000000"
+  client_id            = "11111111-1111-1111-1111-111111111111"
+  client_secret        = var.azure_client_secret
+  storage_account_name = "mylogsaccount"
+  container_name       = "insights-logs"
+  log_stream_type      = "JsonArray"
+
+  log_stream_type_options = {
+    json_array_envelope_field = "records"
+  }
+
+  prefix_log_types = [
+    {
+      prefix            = "signin/"
+      log_types         = ["Azure.MonitorActivity"]
+      excluded_prefixes = []
+    },
+  ]
+}
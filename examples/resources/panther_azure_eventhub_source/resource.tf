@@ -0,0 +1,24 @@
+# Manage an Azure Event Hub Log Source integration in Panther.
+# Panther reads events with an Entra ID app registration that has the Azure Event Hubs
+# Data Receiver role. Give Panther its own consumer group so it doesn't compete with
+# other readers of the hub.
+variable "azure_client_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "panther_azure_eventhub_source" "example" {
+  integration_label = "my-azure-eventhub-logs"
+  tenant_id         = "00000000-0000-0000-0000-000000000000"
+  client_id         = "11111111-1111-1111-1111-111111111111"
+  namespace         = "my-eventhubs-namespace"
+  event_hub_name    = "activity-logs"
+  consumer_group    = "panther"
+  log_stream_type   = "JSON"
+  log_types         = ["Azure.MonitorActivity"]
+
+  # Requires Terraform 1.11+. The secret is sent to Panther but never stored in state;
+  # bump the version to rotate it.
+  client_secret_wo         = var.azure_client_secret
+  client_secret_wo_version = 1
+}
@@ -0,0 +1,14 @@
+# Manage a CloudWatch Logs Log Source integration in Panther.
+# Panther assumes the log processing role to read the log group and, unless
+# managed_subscription is false, to create its subscription filter.
+resource "panther_cloudwatch_source" "example" {
+  integration_label   = "my-vpc-flow-logs"
+  aws_account_id      = "123456789012"
+  region              = "us-east-1"
+  log_group_name      = "/aws/vpc/flow-logs"
+  log_processing_role = "arn:aws:iam::123456789012:role/PantherLogProcessingRole"
+  log_types           = ["AWS.VPCFlow"]
+
+  # Only send rejected traffic to Panther.
+  filter_pattern = "[version, account, eni, source, destination, srcport, destport, protocol, packets, bytes, windowstart, windowend, action=\"REJECT\", flowlogstatus]"
+}
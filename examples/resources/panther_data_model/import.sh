@@ -0,0 +1,2 @@
+# Import an existing data model by its ID.
+terraform import panther_data_model.example Custom.Okta.SystemLog
@@ -0,0 +1,14 @@
+resource "panther_data_model" "okta" {
+  id           = "Custom.Okta.SystemLog"
+  display_name = "Okta System Log"
+  log_type     = "Okta.SystemLog"
+  mappings = [
+    { name = "actor_user", path = "$.actor.alternateId" },
+    { name = "source_ip", path = "$.client.ipAddress" },
+    { name = "event_type", method = "get_event_type" },
+  ]
+  body = <<-EOT
+    def get_event_type(event):
+        return event.get("eventType", "").split(".")[-1]
+  EOT
+}
@@ -0,0 +1,15 @@
+resource "panther_destination_jira" "triage" {
+  display_name = "Security triage"
+  org_domain   = "https://example.atlassian.net"
+  project_key  = "SEC"
+  user_name    = "panther@example.com"
+  api_key      = var.jira_api_token
+  issue_type   = "Bug"
+  labels       = ["panther"]
+  severities   = ["MEDIUM", "HIGH", "CRITICAL"]
+}
+
+variable "jira_api_token" {
+  type      = string
+  sensitive = true
+}
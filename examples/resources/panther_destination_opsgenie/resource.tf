@@ -0,0 +1,11 @@
+resource "panther_destination_opsgenie" "oncall" {
+  display_name   = "Opsgenie on-call"
+  api_key        = var.opsgenie_api_key
+  service_region = "EU"
+  severities     = ["HIGH", "CRITICAL"]
+}
+
+variable "opsgenie_api_key" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,10 @@
+resource "panther_destination_pagerduty" "oncall" {
+  display_name    = "Security on-call"
+  integration_key = var.pagerduty_integration_key
+  severities      = ["CRITICAL"]
+}
+
+variable "pagerduty_integration_key" {
+  type      = string
+  sensitive = true
+}
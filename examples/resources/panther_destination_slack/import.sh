@@ -0,0 +1,2 @@
+# Import an existing destination by its ID.
+terraform import panther_destination_slack.example 6a0f3c1e-2b4d-4e5f-8a9b-0c1d2e3f4a5b
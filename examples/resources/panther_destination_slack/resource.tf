@@ -0,0 +1,12 @@
+# High and critical detection alerts go to the security channel.
+resource "panther_destination_slack" "security" {
+  display_name = "#security-alerts"
+  webhook_url  = var.slack_webhook_url
+  severities   = ["HIGH", "CRITICAL"]
+  alert_types  = ["RULE", "SCHEDULED_RULE", "POLICY"]
+}
+
+variable "slack_webhook_url" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,4 @@
+resource "panther_destination_sns" "alerts" {
+  display_name = "Alerts topic"
+  topic_arn    = "arn:aws:sns:us-east-1:123456789012:panther-alerts"
+}
@@ -0,0 +1,11 @@
+# Only CloudTrail alerts are forwarded to the SOAR platform.
+resource "panther_destination_webhook" "soar" {
+  display_name = "SOAR"
+  url          = var.soar_webhook_url
+  log_types    = ["AWS.CloudTrail"]
+}
+
+variable "soar_webhook_url" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,10 @@
+# Manage an EventBridge Log Source integration in Panther.
+# Panther ingests the events published to the bus, here GuardDuty findings on the
+# account's default bus.
+resource "panther_eventbridge_source" "example" {
+  integration_label = "my-guardduty-findings"
+  aws_account_id    = "123456789012"
+  region            = "us-east-1"
+  bus_name          = "default"
+  log_types         = ["AWS.GuardDuty"]
+}
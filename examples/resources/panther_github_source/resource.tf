@@ -0,0 +1,12 @@
+# Pull the audit log of a GitHub organization with a token of an organization owner.
+variable "github_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "panther_github_source" "example" {
+  integration_label     = "github-audit"
+  organization          = "my-org"
+  personal_access_token = var.github_token
+  pull_interval_minutes = 10
+}
@@ -0,0 +1,2 @@
+# Import an existing global helper by its ID (the module name).
+terraform import panther_global_helper.example ip_helpers
@@ -0,0 +1,28 @@
+resource "panther_global_helper" "ip_helpers" {
+  id          = "ip_helpers"
+  description = "Shared checks on IP addresses"
+  body        = <<-EOT
+    import ipaddress
+
+
+    def is_internal(ip):
+        return ipaddress.ip_address(ip).is_private
+  EOT
+}
+
+# Detections import the helper by its ID. depends_on makes sure it exists before
+# the rule is saved and outlives it on destroy.
+resource "panther_rule" "external_console_login" {
+  id        = "AWS.CloudTrail.ExternalConsoleLogin"
+  severity  = "MEDIUM"
+  log_types = ["AWS.CloudTrail"]
+  body      = <<-EOT
+    import ip_helpers
+
+
+    def rule(event):
+        return event.get("eventName") == "ConsoleLogin" and not ip_helpers.is_internal(event.get("sourceIPAddress"))
+  EOT
+
+  depends_on = [panther_global_helper.ip_helpers]
+}
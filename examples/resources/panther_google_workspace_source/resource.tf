@@ -0,0 +1,8 @@
+# Pull Google Workspace activity reports through a service account with domain-wide
+# delegation, impersonating a super administrator.
+resource "panther_google_workspace_source" "example" {
+  integration_label   = "google-workspace"
+  customer_id         = "C03az79cb"
+  admin_email         = "admin@example.com"
+  service_account_key = file("${path.module}/service-account.json")
+}
@@ -1,13 +1,36 @@
 # Manage Http Log Source integration
 resource "panther_httpsource" "example_http_source" {
-  integration_label = ""
+  integration_label = "example-http-source"
   log_stream_type   = "JSON"
-  log_types         = ""
+  log_types         = ["AWS.CloudTrail"]
   auth_method       = "SharedSecret"
-  auth_header_key   = ""
-  auth_secret_value = ""
-  auth_username     = ""
-  auth_password     = ""
-  auth_hmac_alg     = ""
-  auth_bearer_token = ""
+  auth_header_key   = "x-api-key"
+  auth_secret_value = var.shared_secret
+}
+
+# Only the auth_* attributes used by auth_method may be set:
+#   None:         (none)
+#   Basic:        auth_username, auth_password
+#   Bearer:       auth_bearer_token
+#   HMAC:         auth_header_key, auth_hmac_alg, auth_secret_value
+#   SharedSecret: auth_header_key, auth_secret_value
+variable "shared_secret" {
+  type      = string
+  sensitive = true
+}
+
+# With Terraform 1.11+, pass secrets through the write-only variants so they are
+# never stored in state. Bump the version to send a rotated secret.
+variable "webhook_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "panther_httpsource" "write_only_secret" {
+  integration_label            = "example-bearer-source"
+  log_stream_type              = "JSON"
+  log_types                    = ["AWS.CloudTrail"]
+  auth_method                  = "Bearer"
+  auth_bearer_token_wo         = var.webhook_token
+  auth_bearer_token_wo_version = 1
 }
@@ -1,5 +1,5 @@
-# Log source that the alarm attaches to. In a real configuration this can be any
-# panther_s3_source, panther_httpsource, panther_pubsubsource, or panther_gcssource.
+# Log source that the alarm attaches to. In a real configuration this can be any log
+# source resource, e.g. panther_s3_source or panther_azure_blob_source.
 resource "panther_httpsource" "example" {
   integration_label = "example-http-source"
   log_stream_type   = "JSON"
@@ -13,4 +13,32 @@ resource "panther_log_source_alarm" "example" {
   source_id         = panther_httpsource.example.id
   type              = "SOURCE_NO_DATA"
   minutes_threshold = 60
-}
\ No newline at end of file
+}
+
+# Panther keeps classification, processing, permissions and scanning alarms for every
+# source. Tune when they fire and where they notify; destroying one restores the defaults.
+resource "panther_log_source_alarm" "classification" {
+  source_id            = panther_httpsource.example.id
+  type                 = "SOURCE_CLASSIFICATION_FAILURES"
+  percentage_threshold = 5
+  destination_ids      = [panther_destination_pagerduty.source_health.id]
+}
+
+resource "panther_log_source_alarm" "permissions" {
+  source_id = panther_httpsource.example.id
+  type      = "SOURCE_PERMISSIONS_CHECKS"
+  muted     = true
+}
+
+# Alarm alerts are SYSTEM_ERROR alerts; unless an alarm sets destination_ids, they're
+# routed by the destinations' alert_types.
+resource "panther_destination_pagerduty" "source_health" {
+  display_name    = "Log source health"
+  integration_key = var.pagerduty_integration_key
+  alert_types     = ["SYSTEM_ERROR"]
+}
+
+variable "pagerduty_integration_key" {
+  type      = string
+  sensitive = true
+}
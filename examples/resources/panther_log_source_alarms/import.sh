@@ -0,0 +1,3 @@
+# Import every existing SOURCE_NO_DATA alarm into the set. List all of them in
+# minutes_thresholds afterwards: the next apply deletes any alarm left out.
+terraform import panther_log_source_alarms.no_data SOURCE_NO_DATA
@@ -0,0 +1,14 @@
+# Give every source its own no-data threshold and manage them all in one resource.
+# The alarms are written in parallel, and a failure is reported against its entry.
+variable "no_data_minutes" {
+  description = "No-data alarm threshold in minutes, keyed by log source ID."
+  type        = map(number)
+  default = {
+    "41ed10a4-7791-460a-80b7-c0178baa3595" = 60
+    "5f0b1c2d-3e4f-4a5b-8c6d-7e8f9a0b1c2d" = 1440
+  }
+}
+
+resource "panther_log_source_alarms" "no_data" {
+  minutes_thresholds = var.no_data_minutes
+}
@@ -0,0 +1,4 @@
+# Import an existing lookup table by its ID. For a table with inline_data, the
+# first apply after the import records the configured file, uploading it only if
+# its content differs from the table's data.
+terraform import panther_lookup_table.example 0d6c2a8e-3b7f-4f1e-9c55-2a4b8e6f1d30
@@ -0,0 +1,43 @@
+resource "panther_schema" "asset_inventory" {
+  name = "Custom.AssetInventory"
+  spec = <<-EOT
+    fields:
+      - name: ip
+        type: string
+        indicators: [ip]
+      - name: owner
+        type: string
+  EOT
+}
+
+# Rows uploaded from a file kept next to the configuration. Editing the file
+# re-uploads it on the next apply.
+resource "panther_lookup_table" "asset_inventory" {
+  name        = "asset_inventory"
+  description = "Owners of internal hosts"
+  log_type    = panther_schema.asset_inventory.name
+  primary_key = "ip"
+  associated_log_types = [{
+    log_type  = "AWS.CloudTrail"
+    selectors = ["$.sourceIPAddress"]
+  }]
+  inline_data = {
+    file = "${path.module}/asset_inventory.jsonl"
+  }
+}
+
+# Rows refreshed every hour from an S3 object.
+resource "panther_lookup_table" "ip_reputation" {
+  name        = "ip_reputation"
+  log_type    = "Custom.IPReputation"
+  primary_key = "ip"
+  associated_log_types = [{
+    log_type  = "AWS.VPCFlow"
+    selectors = ["$.srcAddr", "$.dstAddr"]
+  }]
+  s3_source = {
+    object_path            = "s3://example-threat-intel/ip_reputation.csv"
+    role_arn               = "arn:aws:iam::123456789012:role/PantherLookupTableReader"
+    refresh_period_minutes = 60
+  }
+}
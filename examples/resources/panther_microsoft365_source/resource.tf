@@ -0,0 +1,17 @@
+# Subscribe to the Office 365 Management Activity API as an app registration with the
+# ActivityFeed.Read application permission.
+variable "microsoft365_client_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "panther_microsoft365_source" "example" {
+  integration_label = "microsoft-365"
+  tenant_id         = "00000000-0000-0000-0000-000000000000"
+  client_id         = "11111111-1111-1111-1111-111111111111"
+
+  # Requires Terraform 1.11+. The secret is sent to Panther but never stored in state;
+  # bump the version to rotate it.
+  client_secret_wo         = var.microsoft365_client_secret
+  client_secret_wo_version = 1
+}
@@ -0,0 +1,19 @@
+# Pull the System Log of an Okta org. Create the API token as an admin with at least the
+# Read-Only Administrator role.
+variable "okta_api_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "panther_okta_source" "example" {
+  integration_label = "okta-system-log"
+  okta_domain       = "example.okta.com"
+  api_token         = var.okta_api_token
+}
+
+# Alert when the puller stops receiving events.
+resource "panther_log_source_alarm" "okta_no_data" {
+  source_id         = panther_okta_source.example.id
+  type              = "SOURCE_NO_DATA"
+  minutes_threshold = 60
+}
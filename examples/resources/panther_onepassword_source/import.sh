@@ -0,0 +1,3 @@
+# Import an existing 1Password source by its ID. bearer_token can't be read back from
+# Panther, so set it in configuration after importing.
+terraform import panther_onepassword_source.example 5f0b1c2d-3e4f-4a5b-8c6d-7e8f9a0b1c2d
@@ -0,0 +1,11 @@
+# Pull sign-in attempts, item usage and audit events from the 1Password Events API.
+variable "onepassword_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "panther_onepassword_source" "example" {
+  integration_label = "1password"
+  events_api_host   = "events.1password.com"
+  bearer_token      = var.onepassword_token
+}
@@ -0,0 +1,2 @@
+# Import an existing policy by its ID.
+terraform import panther_policy.example AWS.S3.Bucket.EncryptionEnabled
@@ -0,0 +1,19 @@
+resource "panther_policy" "bucket_encryption" {
+  id             = "AWS.S3.Bucket.EncryptionEnabled"
+  display_name   = "S3 bucket encryption enabled"
+  severity       = "MEDIUM"
+  resource_types = ["AWS.S3.Bucket"]
+
+  body = <<-EOT
+    def policy(resource):
+        return resource.get("EncryptionRules") is not None
+  EOT
+
+  tests = [
+    {
+      name            = "unencrypted bucket"
+      expected_result = false
+      resource        = jsonencode({ EncryptionRules = null })
+    },
+  ]
+}
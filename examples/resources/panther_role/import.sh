@@ -0,0 +1,2 @@
+# Import an existing role by its ID.
+terraform import panther_role.example 1b2e8a34-4c53-4a7b-9d1e-2f0c6d7e8a90
@@ -0,0 +1,7 @@
+# A read-only role whose members can only see CloudTrail and VPC flow logs.
+resource "panther_role" "cloud_analyst" {
+  name                 = "Cloud Analyst"
+  permissions          = ["AlertRead", "DataAnalyticsRead", "RuleRead"]
+  log_type_access_kind = "ALLOW"
+  log_type_access      = ["AWS.CloudTrail", "AWS.VPCFlow"]
+}
@@ -0,0 +1,2 @@
+# Import an existing rule by its ID.
+terraform import panther_rule.example AWS.Console.LoginWithoutMFA
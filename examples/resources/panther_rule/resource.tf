@@ -0,0 +1,33 @@
+resource "panther_rule" "console_login_without_mfa" {
+  id                   = "AWS.Console.LoginWithoutMFA"
+  display_name         = "AWS console login without MFA"
+  severity             = "HIGH"
+  log_types            = ["AWS.CloudTrail"]
+  dedup_period_minutes = 60
+  tags                 = ["aws", "identity"]
+  runbook              = "Confirm the login with the user and enforce MFA on their account."
+  reports = {
+    "MITRE ATT&CK" = ["TA0001:T1078"]
+  }
+
+  body = file("${path.module}/rules/console_login_without_mfa.py")
+
+  tests = [
+    {
+      name            = "login without MFA"
+      expected_result = true
+      resource = jsonencode({
+        eventName           = "ConsoleLogin"
+        additionalEventData = { MFAUsed = "No" }
+      })
+    },
+    {
+      name            = "login with MFA"
+      expected_result = false
+      resource = jsonencode({
+        eventName           = "ConsoleLogin"
+        additionalEventData = { MFAUsed = "Yes" }
+      })
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Import an existing saved query by its ID.
+terraform import panther_saved_query.example 5c4e4a3e-5d2b-4d0a-9a43-7f0a3c2e1b6d
@@ -0,0 +1,12 @@
+resource "panther_saved_query" "console_logins" {
+  name        = "Console logins by user"
+  description = "AWS console logins over the last day, grouped by user."
+  sql         = <<-EOT
+    SELECT userIdentity:arn AS user, count(*) AS logins
+    FROM panther_logs.public.aws_cloudtrail
+    WHERE eventName = 'ConsoleLogin'
+      AND p_occurs_since('1 day')
+    GROUP BY user
+    ORDER BY logins DESC
+  EOT
+}
@@ -0,0 +1,2 @@
+# Import an existing scheduled query by its ID.
+terraform import panther_scheduled_query.example 8f1d2b7a-3c4e-4f5a-9b6c-0d1e2f3a4b5c
@@ -0,0 +1,16 @@
+resource "panther_scheduled_query" "okta_failed_logins" {
+  name            = "Okta Failed Logins by IP"
+  description     = "Failed Okta logins per source IP over the last hour."
+  cron_expression = "0 * * * *"
+  timeout_minutes = 5
+  sql             = file("${path.module}/queries/okta_failed_logins.sql")
+}
+
+# Scheduled rules reference scheduled queries by name. Referencing the attribute
+# (rather than repeating the string) makes Terraform create the query first.
+resource "panther_scheduled_rule" "brute_force" {
+  id                = "Okta.BruteForce"
+  severity          = "MEDIUM"
+  scheduled_queries = [panther_scheduled_query.okta_failed_logins.name]
+  body              = file("${path.module}/rules/okta_brute_force.py")
+}
@@ -0,0 +1,2 @@
+# Import an existing scheduled rule by its ID.
+terraform import panther_scheduled_rule.example Okta.BruteForce
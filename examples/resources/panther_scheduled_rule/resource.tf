@@ -0,0 +1,12 @@
+resource "panther_scheduled_rule" "brute_force" {
+  id                = "Okta.BruteForce"
+  display_name      = "Okta brute force by IP"
+  severity          = "MEDIUM"
+  scheduled_queries = ["Okta Failed Logins by IP"]
+  threshold         = 1
+
+  body = <<-EOT
+    def rule(event):
+        return event.get("failed_logins", 0) > 20
+  EOT
+}
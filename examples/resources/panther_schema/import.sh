@@ -0,0 +1,2 @@
+# Import an existing custom schema by its name.
+terraform import panther_schema.example Custom.Firewall
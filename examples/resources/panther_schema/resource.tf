@@ -0,0 +1,22 @@
+# Keep the schema next to the sources that use it. Referencing its name (rather than
+# repeating the string) orders the apply and lets the plan validate the source's log
+# types before the schema exists.
+resource "panther_schema" "firewall" {
+  name          = "Custom.Firewall"
+  description   = "Edge firewall events"
+  reference_url = "https://wiki.example.com/firewall-logs"
+  spec          = file("${path.module}/schemas/firewall.yml")
+}
+
+resource "panther_httpsource" "firewall" {
+  integration_label = "firewall"
+  log_stream_type   = "JSON"
+  log_types         = [panther_schema.firewall.name]
+  auth_method       = "Bearer"
+  auth_bearer_token = var.firewall_token
+}
+
+variable "firewall_token" {
+  type      = string
+  sensitive = true
+}
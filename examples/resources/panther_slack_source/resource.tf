@@ -0,0 +1,12 @@
+# Pull the audit logs of a Slack Enterprise Grid organization with a user token that has
+# the auditlogs:read scope.
+variable "slack_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "panther_slack_source" "example" {
+  integration_label = "slack-audit"
+  enterprise_id     = "E0123ABCDEF"
+  access_token      = var.slack_token
+}
@@ -0,0 +1,2 @@
+# Import an existing SQS source by its ID.
+terraform import panther_sqs_source.example 5f0b1c2d-3e4f-4a5b-8c6d-7e8f9a0b1c2d
@@ -0,0 +1,12 @@
+# Application logs fanned out to Panther from an SNS topic, plus a role that can send
+# to the queue directly.
+resource "panther_sqs_source" "app_logs" {
+  integration_label      = "app-logs"
+  log_types              = ["Custom.AppLogs"]
+  allowed_principal_arns = ["arn:aws:iam::123456789012:role/log-shipper"]
+  allowed_source_arns    = ["arn:aws:sns:us-east-1:123456789012:app-logs"]
+}
+
+output "app_logs_queue_url" {
+  value = panther_sqs_source.app_logs.queue_url
+}
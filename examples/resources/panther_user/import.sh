@@ -0,0 +1,2 @@
+# Import an existing user by its ID.
+terraform import panther_user.example 6f1c9d2a-8e4b-4f3a-b7c5-0d9e2a1b3c4d
@@ -0,0 +1,11 @@
+resource "panther_role" "analyst" {
+  name        = "Analyst"
+  permissions = ["AlertRead", "AlertModify", "DataAnalyticsRead"]
+}
+
+resource "panther_user" "jane" {
+  email       = "jane.doe@example.com"
+  given_name  = "Jane"
+  family_name = "Doe"
+  role_id     = panther_role.analyst.id
+}
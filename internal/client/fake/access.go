@@ -0,0 +1,294 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net"
+	"net/http"
+	"slices"
+	"time"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/google/uuid"
+)
+
+// permissions is the fake instance's catalog of permissions grantable to roles and
+// API tokens.
+var permissions = []string{
+	"AlertModify", "AlertRead", "BulkUpload", "BulkUploadValidate", "CloudsecSourceModify", "CloudsecSourceRead",
+	"DataAnalyticsModify", "DataAnalyticsRead", "DestinationModify", "DestinationRead", "GeneralSettingsModify",
+	"GeneralSettingsRead", "LogSourceModify", "LogSourceRawDataRead", "LogSourceRead", "LookupModify", "LookupRead",
+	"OrganizationAPITokenModify", "OrganizationAPITokenRead", "PolicyModify", "PolicyRead", "ResourceModify",
+	"ResourceRead", "RuleModify", "RuleRead", "SummaryRead", "UserModify", "UserRead",
+}
+
+func (s *Server) registerAccess(mux *http.ServeMux) {
+	mux.HandleFunc("POST /roles", func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[client.RoleInput](w, r)
+		if !ok || !s.checkRole(w, in, "") {
+			return
+		}
+		role := client.Role{ID: newID(), RoleInput: in}
+		s.roles[role.ID] = role
+		writeJSON(w, http.StatusCreated, role)
+	})
+	mux.HandleFunc("GET /roles/{id}", func(w http.ResponseWriter, r *http.Request) {
+		role, ok := s.roles[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "role not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, role)
+	})
+	mux.HandleFunc("PUT /roles/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		role, ok := s.roles[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "role not found")
+			return
+		}
+		in, ok := decode[client.RoleInput](w, r)
+		if !ok || !s.checkRole(w, in, id) {
+			return
+		}
+		role.RoleInput = in
+		s.roles[id] = role
+		writeJSON(w, http.StatusOK, role)
+	})
+	mux.HandleFunc("DELETE /roles/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		role, ok := s.roles[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "role not found")
+			return
+		}
+		for _, user := range s.users {
+			if user.RoleID == id {
+				writeError(w, http.StatusConflict, "role %q is assigned to %s", role.Name, user.Email)
+				return
+			}
+		}
+		delete(s.roles, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("POST /users", func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[client.UserInput](w, r)
+		if !ok || !s.checkUser(w, in, "") {
+			return
+		}
+		user := client.User{ID: newID(), UserInput: in}
+		s.users[user.ID] = user
+		writeJSON(w, http.StatusCreated, user)
+	})
+	mux.HandleFunc("GET /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		user, ok := s.users[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, user)
+	})
+	mux.HandleFunc("PUT /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		user, ok := s.users[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		in, ok := decode[client.UserInput](w, r)
+		if !ok || !s.checkUser(w, in, id) {
+			return
+		}
+		if in.Email != user.Email {
+			writeError(w, http.StatusBadRequest, "email can't be changed")
+			return
+		}
+		user.UserInput = in
+		s.users[id] = user
+		writeJSON(w, http.StatusOK, user)
+	})
+	mux.HandleFunc("DELETE /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.users[id]; !ok {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		delete(s.users, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("POST /api-tokens", func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[client.APITokenInput](w, r)
+		if !ok || !s.checkAPIToken(w, in, "") {
+			return
+		}
+		if in.ExpiresAt != "" {
+			expiry, _ := time.Parse(time.RFC3339, in.ExpiresAt)
+			if !expiry.After(time.Now()) {
+				writeError(w, http.StatusBadRequest, "expiresAt must be in the future")
+				return
+			}
+			in.ExpiresAt = normalizeTimestamp(expiry)
+		}
+		token := client.APIToken{ID: newID(), APITokenInput: in}
+		s.apiTokens[token.ID] = token
+		token.Value = "pat_" + uuid.NewString()
+		writeJSON(w, http.StatusCreated, token)
+	})
+	mux.HandleFunc("GET /api-tokens/{id}", func(w http.ResponseWriter, r *http.Request) {
+		token, ok := s.apiTokens[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "API token not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, token)
+	})
+	mux.HandleFunc("PUT /api-tokens/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		token, ok := s.apiTokens[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "API token not found")
+			return
+		}
+		in, ok := decode[client.APITokenInput](w, r)
+		if !ok || !s.checkAPIToken(w, in, id) {
+			return
+		}
+		if in.ExpiresAt != "" {
+			expiry, _ := time.Parse(time.RFC3339, in.ExpiresAt)
+			in.ExpiresAt = normalizeTimestamp(expiry)
+		}
+		if in.ExpiresAt != token.ExpiresAt {
+			writeError(w, http.StatusBadRequest, "expiresAt can't be changed")
+			return
+		}
+		token.APITokenInput = in
+		s.apiTokens[id] = token
+		writeJSON(w, http.StatusOK, token)
+	})
+	mux.HandleFunc("DELETE /api-tokens/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.apiTokens[id]; !ok {
+			writeError(w, http.StatusNotFound, "API token not found")
+			return
+		}
+		delete(s.apiTokens, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// checkPermissions returns a 400 message for an empty or unknown permission list.
+func checkPermissions(granted []string) string {
+	if len(granted) == 0 {
+		return "permissions must not be empty"
+	}
+	for _, p := range granted {
+		if !slices.Contains(permissions, p) {
+			return "unknown permission " + p
+		}
+	}
+	return ""
+}
+
+// checkRole writes a 400 for an invalid role and a 409 if another role already uses
+// its name. Caller holds s.mu.
+func (s *Server) checkRole(w http.ResponseWriter, in client.RoleInput, exceptID string) bool {
+	msg := checkPermissions(in.Permissions)
+	switch {
+	case msg != "":
+	case in.Name == "":
+		msg = "name must not be empty"
+	case in.LogTypeAccessKind == "ALLOW_ALL" && len(in.LogTypeAccess) > 0:
+		msg = "logTypeAccess must be empty when logTypeAccessKind is ALLOW_ALL"
+	case (in.LogTypeAccessKind == "ALLOW" || in.LogTypeAccessKind == "DENY") && len(in.LogTypeAccess) == 0:
+		msg = "logTypeAccess must not be empty when logTypeAccessKind is " + in.LogTypeAccessKind
+	case !slices.Contains([]string{"ALLOW_ALL", "ALLOW", "DENY"}, in.LogTypeAccessKind):
+		msg = "logTypeAccessKind must be one of ALLOW_ALL, ALLOW, DENY"
+	}
+	for _, logType := range in.LogTypeAccess {
+		if msg == "" && !s.logTypeExists(logType) {
+			msg = "unknown log type " + logType
+		}
+	}
+	if msg != "" {
+		writeError(w, http.StatusBadRequest, "%s", msg)
+		return false
+	}
+	for id, role := range s.roles {
+		if id != exceptID && role.Name == in.Name {
+			writeError(w, http.StatusConflict, "a role named %q already exists", in.Name)
+			return false
+		}
+	}
+	return true
+}
+
+// checkUser writes a 400 for an invalid user and a 409 if another user already has
+// the email. Caller holds s.mu.
+func (s *Server) checkUser(w http.ResponseWriter, in client.UserInput, exceptID string) bool {
+	if _, ok := s.roles[in.RoleID]; !ok {
+		writeError(w, http.StatusBadRequest, "unknown role %q", in.RoleID)
+		return false
+	}
+	if in.Email == "" || in.GivenName == "" || in.FamilyName == "" {
+		writeError(w, http.StatusBadRequest, "email, givenName and familyName are required")
+		return false
+	}
+	for id, user := range s.users {
+		if id != exceptID && user.Email == in.Email {
+			writeError(w, http.StatusConflict, "a user with email %q already exists", in.Email)
+			return false
+		}
+	}
+	return true
+}
+
+// checkAPIToken writes a 400 for an invalid token and a 409 if another token already
+// uses its name. Caller holds s.mu.
+func (s *Server) checkAPIToken(w http.ResponseWriter, in client.APITokenInput, exceptID string) bool {
+	msg := checkPermissions(in.Permissions)
+	if in.Name == "" {
+		msg = "name must not be empty"
+	}
+	for _, cidr := range in.AllowedCIDRBlocks {
+		if _, _, err := net.ParseCIDR(cidr); msg == "" && err != nil {
+			msg = "invalid CIDR block " + cidr
+		}
+	}
+	if _, err := time.Parse(time.RFC3339, in.ExpiresAt); msg == "" && in.ExpiresAt != "" && err != nil {
+		msg = "expiresAt must be an RFC 3339 timestamp"
+	}
+	if msg != "" {
+		writeError(w, http.StatusBadRequest, "%s", msg)
+		return false
+	}
+	for id, token := range s.apiTokens {
+		if id != exceptID && token.Name == in.Name {
+			writeError(w, http.StatusConflict, "an API token named %q already exists", in.Name)
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeTimestamp formats t the way the API returns timestamps: UTC, with
+// milliseconds, whatever offset and precision the request used.
+func normalizeTimestamp(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000Z07:00")
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+
+	"terraform-provider-panther/internal/client"
+)
+
+const alarmTypeSourceNoData = "SOURCE_NO_DATA"
+
+// systemManagedAlarmTypes exist for every source: Panther raises and clears them
+// itself, so only their thresholds and notifications can be configured. Deleting one
+// resets it to these defaults. The value is whether the type takes a rate threshold.
+var systemManagedAlarmTypes = map[string]bool{
+	"SOURCE_PERMISSIONS_CHECKS":      false,
+	"SOURCE_CLASSIFICATION_FAILURES": true,
+	"SOURCE_LOG_PROCESSING_ERRORS":   true,
+	"SOURCE_SCANNING_ERRORS":         false,
+}
+
+// systemManagedAlarmDefault is the configuration of a system-managed alarm nobody has
+// configured: alert on the first failure.
+func systemManagedAlarmDefault(sourceID, alarmType string) client.LogSourceAlarm {
+	alarm := client.LogSourceAlarm{SourceId: sourceID, Type: alarmType}
+	if systemManagedAlarmTypes[alarmType] {
+		alarm.CountThreshold = 1
+	}
+	return alarm
+}
+
+// validateAlarm returns a 400 message for thresholds that don't fit the alarm type, or "".
+func (s *Server) validateAlarm(alarmType string, in client.LogSourceAlarmInput) string {
+	rate, systemManaged := systemManagedAlarmTypes[alarmType]
+	switch {
+	case alarmType == alarmTypeSourceNoData:
+		if in.MinutesThreshold < 15 || in.MinutesThreshold > 43200 {
+			return "minutesThreshold must be between 15 and 43200"
+		}
+		if in.PercentageThreshold != 0 || in.CountThreshold != 0 {
+			return "SOURCE_NO_DATA alarms only take minutesThreshold"
+		}
+	case !systemManaged:
+		return fmt.Sprintf("unknown alarm type %q", alarmType)
+	case in.MinutesThreshold != 0:
+		return fmt.Sprintf("minutesThreshold is not used by %s alarms", alarmType)
+	case rate && (in.PercentageThreshold == 0) == (in.CountThreshold == 0):
+		return fmt.Sprintf("%s alarms take exactly one of percentageThreshold and countThreshold", alarmType)
+	case !rate && (in.PercentageThreshold != 0 || in.CountThreshold != 0):
+		return fmt.Sprintf("%s alarms have no threshold", alarmType)
+	case in.PercentageThreshold < 0 || in.PercentageThreshold > 100:
+		return "percentageThreshold must be between 1 and 100"
+	case in.CountThreshold < 0:
+		return "countThreshold must be positive"
+	}
+	for _, id := range in.DestinationIds {
+		if !s.destinationExists(id) {
+			return "unknown destination " + id
+		}
+	}
+	return ""
+}
+
+// SetAlarmState overrides the runtime state GET reports for an alarm (default "OK").
+func (s *Server) SetAlarmState(sourceID, alarmType, state string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.alarmStates[sourceID] == nil {
+		s.alarmStates[sourceID] = map[string]string{}
+	}
+	s.alarmStates[sourceID][alarmType] = state
+}
+
+// alarmState is the runtime state of an alarm. Caller holds s.mu.
+func (s *Server) alarmState(sourceID, alarmType string) string {
+	if state, ok := s.alarmStates[sourceID][alarmType]; ok {
+		return state
+	}
+	return "OK"
+}
+
+func (s *Server) registerAlarms(mux *http.ServeMux) {
+	// Lists the configured alarms; system-managed alarms left at their defaults aren't
+	// included.
+	mux.HandleFunc("GET /log-source-alarms", func(w http.ResponseWriter, r *http.Request) {
+		alarms := map[string]client.LogSourceAlarm{}
+		for sourceID, byType := range s.alarms {
+			for alarmType, alarm := range byType {
+				alarms[sourceID+"/"+alarmType] = alarm
+			}
+		}
+		writePage(w, r, s.PageSize, alarms, nil)
+	})
+	mux.HandleFunc("PUT /log-source-alarms/{sourceId}/{type}", func(w http.ResponseWriter, r *http.Request) {
+		sourceID, alarmType := r.PathValue("sourceId"), r.PathValue("type")
+		if !s.sourceExists(sourceID) {
+			writeError(w, http.StatusNotFound, "log source was not found")
+			return
+		}
+		in, ok := decode[client.LogSourceAlarmInput](w, r)
+		if !ok {
+			return
+		}
+		if msg := s.validateAlarm(alarmType, in); msg != "" {
+			writeError(w, http.StatusBadRequest, "%s", msg)
+			return
+		}
+		alarm := client.LogSourceAlarm{SourceId: sourceID, Type: alarmType, LogSourceAlarmInput: in}
+		if s.alarms[sourceID] == nil {
+			s.alarms[sourceID] = map[string]client.LogSourceAlarm{}
+		}
+		s.alarms[sourceID][alarmType] = alarm
+		writeJSON(w, http.StatusOK, alarm)
+	})
+	mux.HandleFunc("GET /log-source-alarms/{sourceId}/{type}", func(w http.ResponseWriter, r *http.Request) {
+		sourceID, alarmType := r.PathValue("sourceId"), r.PathValue("type")
+		alarm, ok := s.alarms[sourceID][alarmType]
+		if _, systemManaged := systemManagedAlarmTypes[alarmType]; systemManaged && !ok {
+			if !s.sourceExists(sourceID) {
+				writeError(w, http.StatusNotFound, "log source was not found")
+				return
+			}
+			alarm, ok = systemManagedAlarmDefault(sourceID, alarmType), true
+		}
+		if !ok {
+			writeError(w, http.StatusNotFound, "log source alarm not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, client.LogSourceAlarmStatus{LogSourceAlarm: alarm, State: s.alarmState(sourceID, alarmType)})
+	})
+	mux.HandleFunc("DELETE /log-source-alarms/{sourceId}/{type}", func(w http.ResponseWriter, r *http.Request) {
+		sourceID, alarmType := r.PathValue("sourceId"), r.PathValue("type")
+		_, systemManaged := systemManagedAlarmTypes[alarmType]
+		if systemManaged && s.sourceExists(sourceID) {
+			delete(s.alarms[sourceID], alarmType)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if _, ok := s.alarms[sourceID][alarmType]; !ok {
+			writeError(w, http.StatusNotFound, "log source alarm not found")
+			return
+		}
+		delete(s.alarms[sourceID], alarmType)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
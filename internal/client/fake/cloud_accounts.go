@@ -0,0 +1,93 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+
+	"terraform-provider-panther/internal/client"
+)
+
+func (s *Server) registerAwsCloudAccounts(mux *http.ServeMux) {
+	mux.HandleFunc("POST /cloud-accounts/aws", func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[client.AwsCloudAccountInput](w, r)
+		if !ok {
+			return
+		}
+		if in.IntegrationLabel == "" || in.AwsAccountId == "" || in.AwsScanConfig.AuditRole == "" {
+			writeError(w, http.StatusBadRequest, "integrationLabel, awsAccountId and awsScanConfig.auditRole are required")
+			return
+		}
+		for _, existing := range s.awsAccounts {
+			if existing.AwsAccountId == in.AwsAccountId {
+				writeError(w, http.StatusConflict, "AWS account %s is already onboarded", in.AwsAccountId)
+				return
+			}
+		}
+		account := client.AwsCloudAccount{IntegrationId: newID(), AwsCloudAccountInput: normalizeAwsAccount(in)}
+		s.awsAccounts[account.IntegrationId] = account
+		writeJSON(w, http.StatusCreated, account)
+	})
+	mux.HandleFunc("GET /cloud-accounts/aws/{id}", func(w http.ResponseWriter, r *http.Request) {
+		account, ok := s.awsAccounts[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "cloud account not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, account)
+	})
+	mux.HandleFunc("PUT /cloud-accounts/aws/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		account, ok := s.awsAccounts[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "cloud account not found")
+			return
+		}
+		in, ok := decode[client.AwsCloudAccountInput](w, r)
+		if !ok {
+			return
+		}
+		// awsAccountId is immutable; the real API ignores it on PUT.
+		in.AwsAccountId = account.AwsAccountId
+		account.AwsCloudAccountInput = normalizeAwsAccount(in)
+		s.awsAccounts[id] = account
+		writeJSON(w, http.StatusOK, account)
+	})
+	mux.HandleFunc("DELETE /cloud-accounts/aws/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.awsAccounts[id]; !ok {
+			writeError(w, http.StatusNotFound, "cloud account not found")
+			return
+		}
+		delete(s.awsAccounts, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// normalizeAwsAccount applies the API's guarantee of non-null exclusion lists.
+func normalizeAwsAccount(in client.AwsCloudAccountInput) client.AwsCloudAccountInput {
+	if in.RegionIgnoreList == nil {
+		in.RegionIgnoreList = []string{}
+	}
+	if in.ResourceTypeIgnoreList == nil {
+		in.ResourceTypeIgnoreList = []string{}
+	}
+	if in.ResourceRegexIgnoreList == nil {
+		in.ResourceRegexIgnoreList = []string{}
+	}
+	return in
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"terraform-provider-panther/internal/client"
+)
+
+var pythonIdentifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func (s *Server) registerDataModels(mux *http.ServeMux) {
+	registerPythonKind(s, mux, "/globals", s.globalHelpers, "global helper",
+		func(g client.GlobalHelper) string { return g.ID },
+		func(g *client.GlobalHelper, id string) { g.ID = id },
+		func(g client.GlobalHelper, _ string) (int, string) {
+			switch {
+			case !pythonIdentifierRegex.MatchString(g.ID):
+				return http.StatusBadRequest, "id must be a valid Python module name"
+			case strings.TrimSpace(g.Body) == "":
+				return http.StatusBadRequest, "body must not be empty"
+			}
+			return 0, ""
+		})
+	registerPythonKind(s, mux, "/data-models", s.dataModels, "data model",
+		func(m client.DataModel) string { return m.ID },
+		func(m *client.DataModel, id string) { m.ID = id },
+		s.checkDataModel)
+}
+
+// registerPythonKind serves CRUD for Python analysis items that aren't detections. IDs
+// are client-chosen, so POST conflicts on an existing one. check returns a status and
+// message for an invalid item, or 0.
+func registerPythonKind[T any](s *Server, mux *http.ServeMux, basePath string, store map[string]T, kind string,
+	getID func(T) string, setID func(*T, string), check func(item T, exceptID string) (int, string)) {
+	valid := func(w http.ResponseWriter, item T, exceptID string) bool {
+		if status, msg := check(item, exceptID); status != 0 {
+			writeError(w, status, "%s", msg)
+			return false
+		}
+		return true
+	}
+	mux.HandleFunc("POST "+basePath, func(w http.ResponseWriter, r *http.Request) {
+		item, ok := decode[T](w, r)
+		if !ok || !valid(w, item, getID(item)) {
+			return
+		}
+		id := getID(item)
+		if _, exists := store[id]; exists {
+			writeError(w, http.StatusConflict, "%s %q already exists", kind, id)
+			return
+		}
+		store[id] = item
+		writeJSON(w, http.StatusOK, item)
+	})
+	mux.HandleFunc("GET "+basePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		item, ok := store[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "%s not found", kind)
+			return
+		}
+		writeJSON(w, http.StatusOK, item)
+	})
+	mux.HandleFunc("PUT "+basePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := store[id]; !ok {
+			writeError(w, http.StatusNotFound, "%s not found", kind)
+			return
+		}
+		item, ok := decode[T](w, r)
+		if !ok {
+			return
+		}
+		setID(&item, id)
+		if !valid(w, item, id) {
+			return
+		}
+		store[id] = item
+		writeJSON(w, http.StatusOK, item)
+	})
+	mux.HandleFunc("DELETE "+basePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := store[id]; !ok {
+			writeError(w, http.StatusNotFound, "%s not found", kind)
+			return
+		}
+		delete(store, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// checkDataModel validates a data model, and rejects with 409 a second enabled model
+// for the same log type. Caller holds s.mu.
+func (s *Server) checkDataModel(m client.DataModel, exceptID string) (int, string) {
+	switch {
+	case m.ID == "":
+		return http.StatusBadRequest, "id must not be empty"
+	case len(m.LogTypes) != 1:
+		return http.StatusBadRequest, "logTypes must hold exactly one log type"
+	case !s.logTypeExists(m.LogTypes[0]):
+		return http.StatusBadRequest, "unknown log type " + m.LogTypes[0]
+	case len(m.Mappings) == 0:
+		return http.StatusBadRequest, "mappings must not be empty"
+	}
+	for _, mapping := range m.Mappings {
+		switch {
+		case mapping.Name == "":
+			return http.StatusBadRequest, "mapping name must not be empty"
+		case (mapping.Path == "") == (mapping.Method == ""):
+			return http.StatusBadRequest, "mapping " + mapping.Name + ": exactly one of path and method is required"
+		case mapping.Method != "" && !regexp.MustCompile(`(?m)^def\s+`+regexp.QuoteMeta(mapping.Method)+`\s*\(`).MatchString(m.Body):
+			return http.StatusBadRequest, "mapping " + mapping.Name + ": body does not define " + mapping.Method
+		}
+	}
+	if !m.Enabled {
+		return 0, ""
+	}
+	for id, other := range s.dataModels {
+		if id != exceptID && other.Enabled && len(other.LogTypes) == 1 && other.LogTypes[0] == m.LogTypes[0] {
+			return http.StatusConflict, "log type " + m.LogTypes[0] + " already has an enabled data model, " + id
+		}
+	}
+	return 0, ""
+}
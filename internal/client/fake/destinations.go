@@ -0,0 +1,168 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"terraform-provider-panther/internal/client"
+)
+
+var alertTypes = []string{"RULE", "RULE_ERROR", "SCHEDULED_RULE", "SCHEDULED_RULE_ERROR", "POLICY", "SYSTEM_ERROR"}
+
+func (s *Server) registerDestinations(mux *http.ServeMux) {
+	registerDestinationKind(s, mux, "/destinations/slack", s.slackDestinations,
+		func(d *client.SlackDestination) *client.DestinationCore { return &d.DestinationCore },
+		func(d client.SlackDestination) client.SlackDestination { d.WebhookURL = ""; return d },
+		func(d client.SlackDestination) string {
+			if !strings.HasPrefix(d.WebhookURL, "https://hooks.slack.com/") {
+				return "webhookUrl must be a Slack incoming webhook URL"
+			}
+			return ""
+		})
+	registerDestinationKind(s, mux, "/destinations/pagerduty", s.pagerDutyDestinations,
+		func(d *client.PagerDutyDestination) *client.DestinationCore { return &d.DestinationCore },
+		func(d client.PagerDutyDestination) client.PagerDutyDestination { d.IntegrationKey = ""; return d },
+		func(d client.PagerDutyDestination) string {
+			if len(d.IntegrationKey) != 32 {
+				return "integrationKey must be 32 characters"
+			}
+			return ""
+		})
+	registerDestinationKind(s, mux, "/destinations/webhook", s.webhookDestinations,
+		func(d *client.WebhookDestination) *client.DestinationCore { return &d.DestinationCore },
+		func(d client.WebhookDestination) client.WebhookDestination { d.URL = ""; return d },
+		func(d client.WebhookDestination) string {
+			if !strings.HasPrefix(d.URL, "https://") {
+				return "url must be an https URL"
+			}
+			return ""
+		})
+	registerDestinationKind(s, mux, "/destinations/sns", s.snsDestinations,
+		func(d *client.SNSDestination) *client.DestinationCore { return &d.DestinationCore },
+		nil,
+		func(d client.SNSDestination) string {
+			if !strings.HasPrefix(d.TopicARN, "arn:") {
+				return "topicArn must be an SNS topic ARN"
+			}
+			return ""
+		})
+	registerDestinationKind(s, mux, "/destinations/jira", s.jiraDestinations,
+		func(d *client.JiraDestination) *client.DestinationCore { return &d.DestinationCore },
+		func(d client.JiraDestination) client.JiraDestination { d.APIKey = ""; return d },
+		func(d client.JiraDestination) string {
+			if d.OrgDomain == "" || d.ProjectKey == "" || d.UserName == "" || d.APIKey == "" || d.IssueType == "" {
+				return "orgDomain, projectKey, userName, apiKey and issueType are required"
+			}
+			return ""
+		})
+	registerDestinationKind(s, mux, "/destinations/opsgenie", s.opsgenieDestinations,
+		func(d *client.OpsgenieDestination) *client.DestinationCore { return &d.DestinationCore },
+		func(d client.OpsgenieDestination) client.OpsgenieDestination { d.APIKey = ""; return d },
+		func(d client.OpsgenieDestination) string {
+			if d.APIKey == "" || (d.ServiceRegion != "US" && d.ServiceRegion != "EU") {
+				return "apiKey is required and serviceRegion must be US or EU"
+			}
+			return ""
+		})
+}
+
+// registerDestinationKind serves CRUD for one destination type under basePath. core
+// gives access to the shared fields; redact (nil when the type has no secret) blanks
+// the write-only fields in responses; validate returns a 400 message for type-specific
+// problems. IDs are server-generated.
+func registerDestinationKind[T any](s *Server, mux *http.ServeMux, basePath string, store map[string]T,
+	core func(*T) *client.DestinationCore, redact func(T) T, validate func(T) string) {
+	if redact == nil {
+		redact = func(d T) T { return d }
+	}
+	check := func(w http.ResponseWriter, d T) bool {
+		c := core(&d)
+		msg := validate(d)
+		switch {
+		case c.DisplayName == "":
+			msg = "displayName must not be empty"
+		case len(c.Severities) == 0 || slices.ContainsFunc(c.Severities, func(v string) bool { return !slices.Contains(severities, v) }):
+			msg = "severities must be a non-empty list of INFO, LOW, MEDIUM, HIGH, CRITICAL"
+		case len(c.AlertTypes) == 0 || slices.ContainsFunc(c.AlertTypes, func(v string) bool { return !slices.Contains(alertTypes, v) }):
+			msg = "alertTypes must be a non-empty list of " + strings.Join(alertTypes, ", ")
+		}
+		for _, logType := range c.LogTypes {
+			if msg == "" && !s.logTypeExists(logType) {
+				msg = "unknown log type " + logType
+			}
+		}
+		if msg != "" {
+			writeError(w, http.StatusBadRequest, "%s", msg)
+			return false
+		}
+		return true
+	}
+	mux.HandleFunc("POST "+basePath, func(w http.ResponseWriter, r *http.Request) {
+		d, ok := decode[T](w, r)
+		if !ok || !check(w, d) {
+			return
+		}
+		core(&d).ID = newID()
+		store[core(&d).ID] = d
+		writeJSON(w, http.StatusCreated, redact(d))
+	})
+	mux.HandleFunc("GET "+basePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		d, ok := store[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "destination not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, redact(d))
+	})
+	mux.HandleFunc("PUT "+basePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := store[id]; !ok {
+			writeError(w, http.StatusNotFound, "destination not found")
+			return
+		}
+		d, ok := decode[T](w, r)
+		if !ok || !check(w, d) {
+			return
+		}
+		core(&d).ID = id
+		store[id] = d
+		writeJSON(w, http.StatusOK, redact(d))
+	})
+	mux.HandleFunc("DELETE "+basePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := store[id]; !ok {
+			writeError(w, http.StatusNotFound, "destination not found")
+			return
+		}
+		delete(store, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// destinationExists reports whether id names a destination of any type. Caller holds s.mu.
+func (s *Server) destinationExists(id string) bool {
+	_, slack := s.slackDestinations[id]
+	_, pagerDuty := s.pagerDutyDestinations[id]
+	_, webhook := s.webhookDestinations[id]
+	_, sns := s.snsDestinations[id]
+	_, jira := s.jiraDestinations[id]
+	_, opsgenie := s.opsgenieDestinations[id]
+	return slack || pagerDuty || webhook || sns || jira || opsgenie
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+
+	"terraform-provider-panther/internal/client"
+)
+
+var severities = []string{"INFO", "LOW", "MEDIUM", "HIGH", "CRITICAL"}
+
+func (s *Server) registerDetections(mux *http.ServeMux) {
+	registerDetectionKind(s, mux, "/rules", s.rules,
+		func(r *client.Rule) *client.DetectionCore { return &r.DetectionCore },
+		func(r client.Rule) string {
+			if len(r.LogTypes) == 0 {
+				return "logTypes must not be empty"
+			}
+			for _, logType := range r.LogTypes {
+				if !s.logTypeExists(logType) {
+					return "unknown log type " + logType
+				}
+			}
+			return ""
+		})
+	registerDetectionKind(s, mux, "/scheduled-rules", s.scheduledRules,
+		func(r *client.ScheduledRule) *client.DetectionCore { return &r.DetectionCore },
+		func(r client.ScheduledRule) string {
+			if len(r.ScheduledQueries) == 0 {
+				return "scheduledQueries must not be empty"
+			}
+			for _, name := range r.ScheduledQueries {
+				if !s.scheduledQueryExists(name) {
+					return "unknown scheduled query " + name
+				}
+			}
+			return ""
+		})
+	registerDetectionKind(s, mux, "/policies", s.policies,
+		func(p *client.Policy) *client.DetectionCore { return &p.DetectionCore },
+		func(p client.Policy) string {
+			if len(p.ResourceTypes) == 0 {
+				return "resourceTypes must not be empty"
+			}
+			return ""
+		})
+}
+
+// reformatTests re-serializes the test resources, indented and with sorted keys, like the
+// real API, which doesn't keep the submitted formatting. check has already validated them.
+func reformatTests(c *client.DetectionCore) {
+	for i, test := range c.Tests {
+		var data any
+		if err := json.Unmarshal([]byte(test.Resource), &data); err != nil {
+			continue
+		}
+		if formatted, err := json.MarshalIndent(data, "", "  "); err == nil {
+			c.Tests[i].Resource = string(formatted)
+		}
+	}
+}
+
+// registerDetectionKind serves CRUD for one detection type under basePath. core gives
+// access to the shared fields; validate returns a 400 message for type-specific
+// problems. IDs are client-chosen, so POST conflicts on an existing one.
+func registerDetectionKind[T any](s *Server, mux *http.ServeMux, basePath string, store map[string]T,
+	core func(*T) *client.DetectionCore, validate func(T) string) {
+	check := func(w http.ResponseWriter, d T) bool {
+		c := core(&d)
+		msg := validate(d)
+		switch {
+		case c.Body == "":
+			msg = "body must not be empty"
+		case !slices.Contains(severities, c.Severity):
+			msg = "severity must be one of INFO, LOW, MEDIUM, HIGH, CRITICAL"
+		}
+		for _, id := range c.OutputIDs {
+			if msg == "" && !s.destinationExists(id) {
+				msg = "unknown destination " + id
+			}
+		}
+		for _, test := range c.Tests {
+			if msg == "" && !json.Valid([]byte(test.Resource)) {
+				msg = "test " + test.Name + ": resource is not valid JSON"
+			}
+		}
+		if msg != "" {
+			writeError(w, http.StatusBadRequest, "%s", msg)
+			return false
+		}
+		return true
+	}
+	mux.HandleFunc("POST "+basePath, func(w http.ResponseWriter, r *http.Request) {
+		d, ok := decode[T](w, r)
+		if !ok || !check(w, d) {
+			return
+		}
+		reformatTests(core(&d))
+		id := core(&d).ID
+		if id == "" {
+			writeError(w, http.StatusBadRequest, "id must not be empty")
+			return
+		}
+		if _, exists := store[id]; exists {
+			writeError(w, http.StatusConflict, "%s %q already exists", basePath, id)
+			return
+		}
+		store[id] = d
+		writeJSON(w, http.StatusOK, d)
+	})
+	mux.HandleFunc("GET "+basePath, func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, s.PageSize, store, nil)
+	})
+	mux.HandleFunc("GET "+basePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		d, ok := store[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "detection not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, d)
+	})
+	mux.HandleFunc("PUT "+basePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := store[id]; !ok {
+			writeError(w, http.StatusNotFound, "detection not found")
+			return
+		}
+		d, ok := decode[T](w, r)
+		if !ok || !check(w, d) {
+			return
+		}
+		core(&d).ID = id
+		reformatTests(core(&d))
+		store[id] = d
+		writeJSON(w, http.StatusOK, d)
+	})
+	mux.HandleFunc("DELETE "+basePath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := store[id]; !ok {
+			writeError(w, http.StatusNotFound, "detection not found")
+			return
+		}
+		delete(store, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
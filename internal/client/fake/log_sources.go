@@ -0,0 +1,827 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"terraform-provider-panther/internal/client"
+)
+
+func (s *Server) registerS3(mux *http.ServeMux) {
+	mux.HandleFunc("POST /log-sources/s3", func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[client.S3SourceCreateInput](w, r)
+		if !ok {
+			return
+		}
+		if in.IntegrationLabel == "" || in.AwsAccountId == "" || in.S3Bucket == "" || in.LogProcessingRole == "" {
+			writeError(w, http.StatusBadRequest, "integrationLabel, awsAccountId, s3Bucket and logProcessingRole are required")
+			return
+		}
+		if s.labelTaken(in.IntegrationLabel, "") {
+			writeError(w, http.StatusConflict, "a log source with label %q already exists", in.IntegrationLabel)
+			return
+		}
+		src := client.S3Source{
+			IntegrationId:              newID(),
+			IntegrationLabel:           in.IntegrationLabel,
+			AwsAccountId:               in.AwsAccountId,
+			S3Bucket:                   in.S3Bucket,
+			KmsKey:                     in.KmsKey,
+			LogProcessingRole:          in.LogProcessingRole,
+			LogStreamType:              in.LogStreamType,
+			LogStreamTypeOptions:       s3Options(in.LogStreamTypeOptions),
+			ManagedBucketNotifications: in.ManagedBucketNotifications,
+			S3PrefixLogTypes:           in.S3PrefixLogTypes,
+		}
+		s.s3[src.IntegrationId] = src
+		writeJSON(w, http.StatusCreated, src)
+	})
+	mux.HandleFunc("GET /log-sources/s3", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, s.PageSize, s.s3, nil)
+	})
+	mux.HandleFunc("GET /log-sources/s3/{id}", func(w http.ResponseWriter, r *http.Request) {
+		src, ok := s.s3[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "s3 source not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, src)
+	})
+	mux.HandleFunc("PUT /log-sources/s3/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		src, ok := s.s3[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "s3 source not found")
+			return
+		}
+		in, ok := decode[client.S3SourceUpdateInput](w, r)
+		if !ok {
+			return
+		}
+		if s.labelTaken(in.IntegrationLabel, id) {
+			writeError(w, http.StatusConflict, "a log source with label %q already exists", in.IntegrationLabel)
+			return
+		}
+		src.IntegrationLabel = in.IntegrationLabel
+		src.KmsKey = in.KmsKey
+		src.LogProcessingRole = in.LogProcessingRole
+		src.LogStreamType = in.LogStreamType
+		src.LogStreamTypeOptions = s3Options(in.LogStreamTypeOptions)
+		src.ManagedBucketNotifications = in.ManagedBucketNotifications
+		src.S3PrefixLogTypes = in.S3PrefixLogTypes
+		s.s3[id] = src
+		writeJSON(w, http.StatusOK, src)
+	})
+	mux.HandleFunc("DELETE /log-sources/s3/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.s3[id]; !ok {
+			writeError(w, http.StatusNotFound, "s3 source not found")
+			return
+		}
+		delete(s.s3, id)
+		s.deleteSource(id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// s3Options reproduces the S3 API quirk of returning {} rather than null when
+// no stream options are set.
+func s3Options(opts *client.S3LogStreamTypeOptions) *client.S3LogStreamTypeOptions {
+	if opts == nil {
+		return &client.S3LogStreamTypeOptions{}
+	}
+	return opts
+}
+
+func (s *Server) registerHTTP(mux *http.ServeMux) {
+	mux.HandleFunc("POST /log-sources/http", func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[client.HttpSourceInput](w, r)
+		if !ok {
+			return
+		}
+		if in.IntegrationLabel == "" || len(in.LogTypes) == 0 {
+			writeError(w, http.StatusBadRequest, "integrationLabel and logTypes are required")
+			return
+		}
+		if msg := missingHTTPAuth(in); msg != "" {
+			writeError(w, http.StatusBadRequest, "%s", msg)
+			return
+		}
+		if s.labelTaken(in.IntegrationLabel, "") {
+			writeError(w, http.StatusConflict, "a log source with label %q already exists", in.IntegrationLabel)
+			return
+		}
+		src := client.HttpSource{IntegrationId: newID(), HttpSourceInput: in}
+		s.http[src.IntegrationId] = src
+		writeJSON(w, http.StatusCreated, redactHTTP(src))
+	})
+	mux.HandleFunc("GET /log-sources/http", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, s.PageSize, s.http, redactHTTP)
+	})
+	mux.HandleFunc("GET /log-sources/http/{id}", func(w http.ResponseWriter, r *http.Request) {
+		src, ok := s.http[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "http source not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, redactHTTP(src))
+	})
+	mux.HandleFunc("PUT /log-sources/http/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.http[id]; !ok {
+			writeError(w, http.StatusNotFound, "http source not found")
+			return
+		}
+		in, ok := decode[client.HttpSourceInput](w, r)
+		if !ok {
+			return
+		}
+		if msg := missingHTTPAuth(in); msg != "" {
+			writeError(w, http.StatusBadRequest, "%s", msg)
+			return
+		}
+		if s.labelTaken(in.IntegrationLabel, id) {
+			writeError(w, http.StatusConflict, "a log source with label %q already exists", in.IntegrationLabel)
+			return
+		}
+		src := client.HttpSource{IntegrationId: id, HttpSourceInput: in}
+		s.http[id] = src
+		writeJSON(w, http.StatusOK, redactHTTP(src))
+	})
+	mux.HandleFunc("DELETE /log-sources/http/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.http[id]; !ok {
+			writeError(w, http.StatusNotFound, "http source not found")
+			return
+		}
+		delete(s.http, id)
+		s.deleteSource(id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// missingHTTPAuth reports the auth fields in.AuthMethod needs but doesn't have,
+// or "" if the input is complete. Secrets are required on update too: the API
+// replaces the stored ones with whatever is sent.
+func missingHTTPAuth(in client.HttpSourceInput) string {
+	var missing []string
+	require := func(field, value string) {
+		if value == "" {
+			missing = append(missing, field)
+		}
+	}
+	switch in.AuthMethod {
+	case "SharedSecret":
+		require("authHeaderKey", in.AuthHeaderKey)
+		require("authSecretValue", in.AuthSecretValue)
+	case "HMAC":
+		require("authHeaderKey", in.AuthHeaderKey)
+		require("authSecretValue", in.AuthSecretValue)
+		require("authHmacAlg", in.AuthHmacAlg)
+	case "Bearer":
+		require("authBearerToken", in.AuthBearerToken)
+	case "Basic":
+		require("authUsername", in.AuthUsername)
+		require("authPassword", in.AuthPassword)
+	}
+	if len(missing) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("auth method %s requires %s", in.AuthMethod, strings.Join(missing, ", "))
+}
+
+// redactHTTP blanks the auth secrets, which the real API never returns.
+func redactHTTP(src client.HttpSource) client.HttpSource {
+	src.AuthPassword = ""
+	src.AuthSecretValue = ""
+	src.AuthBearerToken = ""
+	return src
+}
+
+// gcpCredentials extracts what the API derives from a GCP credentials document:
+// the credentials type (service account keyfile vs. Workload Identity Federation
+// config) and, for service accounts, the project ID.
+func gcpCredentials(raw string) (credentialsType, projectID string, ok bool) {
+	var doc struct {
+		Type      string `json:"type"`
+		ProjectID string `json:"project_id"`
+	}
+	if err := json.Unmarshal([]byte(raw), &doc); err != nil {
+		return "", "", false
+	}
+	switch doc.Type {
+	case "service_account":
+		return "service_account", doc.ProjectID, true
+	case "external_account":
+		return "wif", "", true
+	}
+	return "", "", false
+}
+
+// resolveGCP validates credentials and fills in the derived fields shared by
+// GCS and Pub/Sub sources. previous is the stored credentials on update, used
+// when the caller omits them. Returns false after writing a 400.
+func resolveGCP(w http.ResponseWriter, credentials, previous string, projectID *string, credentialsType *string) (string, bool) {
+	if credentials == "" {
+		credentials = previous
+	}
+	derivedType, derivedProject, ok := gcpCredentials(credentials)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "credentials must be a GCP service account keyfile or workload identity federation config")
+		return "", false
+	}
+	*credentialsType = derivedType
+	if *projectID == "" {
+		*projectID = derivedProject
+	}
+	if *projectID == "" {
+		writeError(w, http.StatusBadRequest, "projectId is required when it cannot be derived from the credentials")
+		return "", false
+	}
+	return credentials, true
+}
+
+func (s *Server) registerGCS(mux *http.ServeMux) {
+	subscriptionTaken := func(subscriptionID, exceptID string) bool {
+		for id, src := range s.gcs {
+			if id != exceptID && src.SubscriptionId == subscriptionID {
+				return true
+			}
+		}
+		return false
+	}
+	save := func(w http.ResponseWriter, id, previous string, in client.GcsSourceInput, status int) {
+		if in.IntegrationLabel == "" || in.SubscriptionId == "" || in.GcsBucket == "" {
+			writeError(w, http.StatusBadRequest, "integrationLabel, subscriptionId and gcsBucket are required")
+			return
+		}
+		if s.labelTaken(in.IntegrationLabel, id) {
+			writeError(w, http.StatusConflict, "a log source with label %q already exists", in.IntegrationLabel)
+			return
+		}
+		if subscriptionTaken(in.SubscriptionId, id) {
+			writeError(w, http.StatusConflict, "subscription %q is already used by another source", in.SubscriptionId)
+			return
+		}
+		credentials, ok := resolveGCP(w, in.Credentials, previous, &in.ProjectId, &in.CredentialsType)
+		if !ok {
+			return
+		}
+		in.Credentials = credentials
+		src := client.GcsSource{IntegrationId: id, GcsSourceInput: in}
+		s.gcs[id] = src
+		src.Credentials = ""
+		writeJSON(w, status, src)
+	}
+
+	mux.HandleFunc("POST /log-sources/gcs", func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[client.GcsSourceInput](w, r)
+		if !ok {
+			return
+		}
+		save(w, newID(), "", in, http.StatusCreated)
+	})
+	mux.HandleFunc("GET /log-sources/gcs", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, s.PageSize, s.gcs, func(src client.GcsSource) client.GcsSource {
+			src.Credentials = ""
+			return src
+		})
+	})
+	mux.HandleFunc("GET /log-sources/gcs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		src, ok := s.gcs[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "gcs source not found")
+			return
+		}
+		src.Credentials = ""
+		writeJSON(w, http.StatusOK, src)
+	})
+	mux.HandleFunc("PUT /log-sources/gcs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		existing, ok := s.gcs[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "gcs source not found")
+			return
+		}
+		in, ok := decode[client.GcsSourceInput](w, r)
+		if !ok {
+			return
+		}
+		save(w, id, existing.Credentials, in, http.StatusOK)
+	})
+	mux.HandleFunc("DELETE /log-sources/gcs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.gcs[id]; !ok {
+			writeError(w, http.StatusNotFound, "gcs source not found")
+			return
+		}
+		delete(s.gcs, id)
+		s.deleteSource(id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *Server) registerPubSub(mux *http.ServeMux) {
+	subscriptionTaken := func(subscriptionID, exceptID string) bool {
+		for id, src := range s.pubsub {
+			if id != exceptID && src.SubscriptionId == subscriptionID {
+				return true
+			}
+		}
+		return false
+	}
+	save := func(w http.ResponseWriter, id, previous string, in client.PubSubSourceInput, status int) {
+		if in.IntegrationLabel == "" || in.SubscriptionId == "" || len(in.LogTypes) == 0 {
+			writeError(w, http.StatusBadRequest, "integrationLabel, subscriptionId and logTypes are required")
+			return
+		}
+		if s.labelTaken(in.IntegrationLabel, id) {
+			writeError(w, http.StatusConflict, "a log source with label %q already exists", in.IntegrationLabel)
+			return
+		}
+		if subscriptionTaken(in.SubscriptionId, id) {
+			writeError(w, http.StatusConflict, "subscription %q is already used by another source", in.SubscriptionId)
+			return
+		}
+		credentials, ok := resolveGCP(w, in.Credentials, previous, &in.ProjectId, &in.CredentialsType)
+		if !ok {
+			return
+		}
+		in.Credentials = credentials
+		src := client.PubSubSource{IntegrationId: id, PubSubSourceInput: in}
+		s.pubsub[id] = src
+		src.Credentials = ""
+		writeJSON(w, status, src)
+	}
+
+	mux.HandleFunc("POST /log-sources/pubsub", func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[client.PubSubSourceInput](w, r)
+		if !ok {
+			return
+		}
+		save(w, newID(), "", in, http.StatusCreated)
+	})
+	mux.HandleFunc("GET /log-sources/pubsub", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, s.PageSize, s.pubsub, func(src client.PubSubSource) client.PubSubSource {
+			src.Credentials = ""
+			return src
+		})
+	})
+	mux.HandleFunc("GET /log-sources/pubsub/{id}", func(w http.ResponseWriter, r *http.Request) {
+		src, ok := s.pubsub[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "pubsub source not found")
+			return
+		}
+		src.Credentials = ""
+		writeJSON(w, http.StatusOK, src)
+	})
+	mux.HandleFunc("PUT /log-sources/pubsub/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		existing, ok := s.pubsub[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "pubsub source not found")
+			return
+		}
+		in, ok := decode[client.PubSubSourceInput](w, r)
+		if !ok {
+			return
+		}
+		save(w, id, existing.Credentials, in, http.StatusOK)
+	})
+	mux.HandleFunc("DELETE /log-sources/pubsub/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.pubsub[id]; !ok {
+			writeError(w, http.StatusNotFound, "pubsub source not found")
+			return
+		}
+		delete(s.pubsub, id)
+		s.deleteSource(id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// sqsQueueURLPrefix is where the fake pretends to create source queues: the real API
+// creates them in the Panther instance's own AWS account.
+const sqsQueueURLPrefix = "https://sqs.us-east-1.amazonaws.com/111122223333/panther-source-"
+
+func (s *Server) registerSQS(mux *http.ServeMux) {
+	save := func(w http.ResponseWriter, id string, in client.SqsSourceInput, status int) {
+		if in.IntegrationLabel == "" || len(in.LogTypes) == 0 {
+			writeError(w, http.StatusBadRequest, "integrationLabel and logTypes are required")
+			return
+		}
+		for _, arn := range append(slices.Clone(in.AllowedPrincipalArns), in.AllowedSourceArns...) {
+			if !strings.HasPrefix(arn, "arn:") {
+				writeError(w, http.StatusBadRequest, "%q is not an ARN", arn)
+				return
+			}
+		}
+		if s.labelTaken(in.IntegrationLabel, id) {
+			writeError(w, http.StatusConflict, "a log source with label %q already exists", in.IntegrationLabel)
+			return
+		}
+		src := client.SqsSource{IntegrationId: id, SqsSourceInput: in, QueueUrl: sqsQueueURLPrefix + id}
+		s.sqs[id] = src
+		writeJSON(w, status, src)
+	}
+
+	mux.HandleFunc("POST /log-sources/sqs", func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[client.SqsSourceInput](w, r)
+		if !ok {
+			return
+		}
+		save(w, newID(), in, http.StatusCreated)
+	})
+	mux.HandleFunc("GET /log-sources/sqs", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, s.PageSize, s.sqs, nil)
+	})
+	mux.HandleFunc("GET /log-sources/sqs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		src, ok := s.sqs[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "sqs source not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, src)
+	})
+	mux.HandleFunc("PUT /log-sources/sqs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.sqs[id]; !ok {
+			writeError(w, http.StatusNotFound, "sqs source not found")
+			return
+		}
+		in, ok := decode[client.SqsSourceInput](w, r)
+		if !ok {
+			return
+		}
+		save(w, id, in, http.StatusOK)
+	})
+	mux.HandleFunc("DELETE /log-sources/sqs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.sqs[id]; !ok {
+			writeError(w, http.StatusNotFound, "sqs source not found")
+			return
+		}
+		delete(s.sqs, id)
+		s.deleteSource(id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+var azureGUIDRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// resolveAzureCredentials checks the app registration shared by the Azure sources.
+// previous is the stored secret on update, kept when the caller omits it. Returns
+// false after writing a 400.
+func resolveAzureCredentials(w http.ResponseWriter, tenantID, clientID string, clientSecret *string, previous string) bool {
+	if !azureGUIDRegex.MatchString(tenantID) || !azureGUIDRegex.MatchString(clientID) {
+		writeError(w, http.StatusBadRequest, "tenantId and clientId must be GUIDs")
+		return false
+	}
+	if *clientSecret == "" {
+		*clientSecret = previous
+	}
+	if *clientSecret == "" {
+		writeError(w, http.StatusBadRequest, "clientSecret is required")
+		return false
+	}
+	return true
+}
+
+func (s *Server) registerAzureBlob(mux *http.ServeMux) {
+	containerTaken := func(account, container, exceptID string) bool {
+		for id, src := range s.azureBlob {
+			if id != exceptID && src.StorageAccountName == account && src.ContainerName == container {
+				return true
+			}
+		}
+		return false
+	}
+	save := func(w http.ResponseWriter, id, previous string, in client.AzureBlobSourceInput, status int) {
+		if in.IntegrationLabel == "" || in.StorageAccountName == "" || in.ContainerName == "" || len(in.PrefixLogTypes) == 0 {
+			writeError(w, http.StatusBadRequest, "integrationLabel, storageAccountName, containerName and prefixLogTypes are required")
+			return
+		}
+		if !resolveAzureCredentials(w, in.TenantId, in.ClientId, &in.ClientSecret, previous) {
+			return
+		}
+		if s.labelTaken(in.IntegrationLabel, id) {
+			writeError(w, http.StatusConflict, "a log source with label %q already exists", in.IntegrationLabel)
+			return
+		}
+		if containerTaken(in.StorageAccountName, in.ContainerName, id) {
+			writeError(w, http.StatusConflict, "container %s/%s is already used by another source", in.StorageAccountName, in.ContainerName)
+			return
+		}
+		src := client.AzureBlobSource{IntegrationId: id, AzureBlobSourceInput: in}
+		s.azureBlob[id] = src
+		src.ClientSecret = ""
+		writeJSON(w, status, src)
+	}
+
+	mux.HandleFunc("POST /log-sources/azure-blob", func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[client.AzureBlobSourceInput](w, r)
+		if !ok {
+			return
+		}
+		save(w, newID(), "", in, http.StatusCreated)
+	})
+	mux.HandleFunc("GET /log-sources/azure-blob", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, s.PageSize, s.azureBlob, func(src client.AzureBlobSource) client.AzureBlobSource {
+			src.ClientSecret = ""
+			return src
+		})
+	})
+	mux.HandleFunc("GET /log-sources/azure-blob/{id}", func(w http.ResponseWriter, r *http.Request) {
+		src, ok := s.azureBlob[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "azure blob source not found")
+			return
+		}
+		src.ClientSecret = ""
+		writeJSON(w, http.StatusOK, src)
+	})
+	mux.HandleFunc("PUT /log-sources/azure-blob/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		existing, ok := s.azureBlob[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "azure blob source not found")
+			return
+		}
+		in, ok := decode[client.AzureBlobSourceInput](w, r)
+		if !ok {
+			return
+		}
+		save(w, id, existing.ClientSecret, in, http.StatusOK)
+	})
+	mux.HandleFunc("DELETE /log-sources/azure-blob/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.azureBlob[id]; !ok {
+			writeError(w, http.StatusNotFound, "azure blob source not found")
+			return
+		}
+		delete(s.azureBlob, id)
+		s.deleteSource(id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *Server) registerAzureEventHub(mux *http.ServeMux) {
+	// Two sources reading as the same consumer group would split the hub's events
+	// between them, so the API rejects it.
+	consumerTaken := func(in client.AzureEventHubSourceInput, exceptID string) bool {
+		for id, src := range s.azureEventHub {
+			if id != exceptID && src.Namespace == in.Namespace && src.EventHubName == in.EventHubName &&
+				src.ConsumerGroup == in.ConsumerGroup {
+				return true
+			}
+		}
+		return false
+	}
+	save := func(w http.ResponseWriter, id, previous string, in client.AzureEventHubSourceInput, status int) {
+		if in.IntegrationLabel == "" || in.Namespace == "" || in.EventHubName == "" || len(in.LogTypes) == 0 {
+			writeError(w, http.StatusBadRequest, "integrationLabel, namespace, eventHubName and logTypes are required")
+			return
+		}
+		if in.ConsumerGroup == "" {
+			in.ConsumerGroup = "$Default"
+		}
+		if !resolveAzureCredentials(w, in.TenantId, in.ClientId, &in.ClientSecret, previous) {
+			return
+		}
+		if s.labelTaken(in.IntegrationLabel, id) {
+			writeError(w, http.StatusConflict, "a log source with label %q already exists", in.IntegrationLabel)
+			return
+		}
+		if consumerTaken(in, id) {
+			writeError(w, http.StatusConflict, "consumer group %s of %s/%s is already used by another source",
+				in.ConsumerGroup, in.Namespace, in.EventHubName)
+			return
+		}
+		src := client.AzureEventHubSource{IntegrationId: id, AzureEventHubSourceInput: in}
+		s.azureEventHub[id] = src
+		src.ClientSecret = ""
+		writeJSON(w, status, src)
+	}
+
+	mux.HandleFunc("POST /log-sources/azure-eventhub", func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[client.AzureEventHubSourceInput](w, r)
+		if !ok {
+			return
+		}
+		save(w, newID(), "", in, http.StatusCreated)
+	})
+	mux.HandleFunc("GET /log-sources/azure-eventhub", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, s.PageSize, s.azureEventHub, func(src client.AzureEventHubSource) client.AzureEventHubSource {
+			src.ClientSecret = ""
+			return src
+		})
+	})
+	mux.HandleFunc("GET /log-sources/azure-eventhub/{id}", func(w http.ResponseWriter, r *http.Request) {
+		src, ok := s.azureEventHub[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "azure event hub source not found")
+			return
+		}
+		src.ClientSecret = ""
+		writeJSON(w, http.StatusOK, src)
+	})
+	mux.HandleFunc("PUT /log-sources/azure-eventhub/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		existing, ok := s.azureEventHub[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "azure event hub source not found")
+			return
+		}
+		in, ok := decode[client.AzureEventHubSourceInput](w, r)
+		if !ok {
+			return
+		}
+		save(w, id, existing.ClientSecret, in, http.StatusOK)
+	})
+	mux.HandleFunc("DELETE /log-sources/azure-eventhub/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.azureEventHub[id]; !ok {
+			writeError(w, http.StatusNotFound, "azure event hub source not found")
+			return
+		}
+		delete(s.azureEventHub, id)
+		s.deleteSource(id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *Server) registerCloudWatch(mux *http.ServeMux) {
+	// A log group has room for only two subscription filters, so the API allows one
+	// Panther source per group.
+	groupTaken := func(in client.CloudWatchSourceInput, exceptID string) bool {
+		for id, src := range s.cloudWatch {
+			if id != exceptID && src.AwsAccountId == in.AwsAccountId && src.Region == in.Region &&
+				src.LogGroupName == in.LogGroupName {
+				return true
+			}
+		}
+		return false
+	}
+	save := func(w http.ResponseWriter, id string, in client.CloudWatchSourceInput, status int) {
+		if in.IntegrationLabel == "" || in.AwsAccountId == "" || in.Region == "" || in.LogGroupName == "" ||
+			in.LogProcessingRole == "" || len(in.LogTypes) == 0 {
+			writeError(w, http.StatusBadRequest,
+				"integrationLabel, awsAccountId, region, logGroupName, logProcessingRole and logTypes are required")
+			return
+		}
+		if s.labelTaken(in.IntegrationLabel, id) {
+			writeError(w, http.StatusConflict, "a log source with label %q already exists", in.IntegrationLabel)
+			return
+		}
+		if groupTaken(in, id) {
+			writeError(w, http.StatusConflict, "log group %s in %s/%s is already used by another source",
+				in.LogGroupName, in.AwsAccountId, in.Region)
+			return
+		}
+		src := client.CloudWatchSource{IntegrationId: id, CloudWatchSourceInput: in}
+		s.cloudWatch[id] = src
+		writeJSON(w, status, src)
+	}
+
+	mux.HandleFunc("POST /log-sources/cloudwatch", func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[client.CloudWatchSourceInput](w, r)
+		if !ok {
+			return
+		}
+		save(w, newID(), in, http.StatusCreated)
+	})
+	mux.HandleFunc("GET /log-sources/cloudwatch", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, s.PageSize, s.cloudWatch, nil)
+	})
+	mux.HandleFunc("GET /log-sources/cloudwatch/{id}", func(w http.ResponseWriter, r *http.Request) {
+		src, ok := s.cloudWatch[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "cloudwatch source not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, src)
+	})
+	mux.HandleFunc("PUT /log-sources/cloudwatch/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		existing, ok := s.cloudWatch[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "cloudwatch source not found")
+			return
+		}
+		in, ok := decode[client.CloudWatchSourceInput](w, r)
+		if !ok {
+			return
+		}
+		if in.AwsAccountId != existing.AwsAccountId || in.Region != existing.Region || in.LogGroupName != existing.LogGroupName {
+			writeError(w, http.StatusBadRequest, "awsAccountId, region and logGroupName can't be changed")
+			return
+		}
+		save(w, id, in, http.StatusOK)
+	})
+	mux.HandleFunc("DELETE /log-sources/cloudwatch/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.cloudWatch[id]; !ok {
+			writeError(w, http.StatusNotFound, "cloudwatch source not found")
+			return
+		}
+		delete(s.cloudWatch, id)
+		s.deleteSource(id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *Server) registerEventBridge(mux *http.ServeMux) {
+	busTaken := func(in client.EventBridgeSourceInput, exceptID string) bool {
+		for id, src := range s.eventBridge {
+			if id != exceptID && src.AwsAccountId == in.AwsAccountId && src.Region == in.Region && src.BusName == in.BusName {
+				return true
+			}
+		}
+		return false
+	}
+	save := func(w http.ResponseWriter, id string, in client.EventBridgeSourceInput, status int) {
+		if in.IntegrationLabel == "" || in.AwsAccountId == "" || in.Region == "" || in.BusName == "" || len(in.LogTypes) == 0 {
+			writeError(w, http.StatusBadRequest, "integrationLabel, awsAccountId, region, busName and logTypes are required")
+			return
+		}
+		if s.labelTaken(in.IntegrationLabel, id) {
+			writeError(w, http.StatusConflict, "a log source with label %q already exists", in.IntegrationLabel)
+			return
+		}
+		if busTaken(in, id) {
+			writeError(w, http.StatusConflict, "event bus %s in %s/%s is already used by another source",
+				in.BusName, in.AwsAccountId, in.Region)
+			return
+		}
+		src := client.EventBridgeSource{IntegrationId: id, EventBridgeSourceInput: in}
+		s.eventBridge[id] = src
+		writeJSON(w, status, src)
+	}
+
+	mux.HandleFunc("POST /log-sources/eventbridge", func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[client.EventBridgeSourceInput](w, r)
+		if !ok {
+			return
+		}
+		save(w, newID(), in, http.StatusCreated)
+	})
+	mux.HandleFunc("GET /log-sources/eventbridge", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, s.PageSize, s.eventBridge, nil)
+	})
+	mux.HandleFunc("GET /log-sources/eventbridge/{id}", func(w http.ResponseWriter, r *http.Request) {
+		src, ok := s.eventBridge[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "eventbridge source not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, src)
+	})
+	mux.HandleFunc("PUT /log-sources/eventbridge/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		existing, ok := s.eventBridge[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "eventbridge source not found")
+			return
+		}
+		in, ok := decode[client.EventBridgeSourceInput](w, r)
+		if !ok {
+			return
+		}
+		if in.AwsAccountId != existing.AwsAccountId || in.Region != existing.Region || in.BusName != existing.BusName {
+			writeError(w, http.StatusBadRequest, "awsAccountId, region and busName can't be changed")
+			return
+		}
+		save(w, id, in, http.StatusOK)
+	})
+	mux.HandleFunc("DELETE /log-sources/eventbridge/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.eventBridge[id]; !ok {
+			writeError(w, http.StatusNotFound, "eventbridge source not found")
+			return
+		}
+		delete(s.eventBridge, id)
+		s.deleteSource(id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"terraform-provider-panther/internal/client"
+)
+
+func (s *Server) registerLookupTables(mux *http.ServeMux) {
+	mux.HandleFunc("POST /lookup-tables", func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[client.LookupTableInput](w, r)
+		if !ok || !s.checkLookupTable(w, in, "") {
+			return
+		}
+		table := client.LookupTable{ID: newID(), LookupTableInput: in}
+		s.lookupTables[table.ID] = table
+		writeJSON(w, http.StatusCreated, table)
+	})
+	mux.HandleFunc("GET /lookup-tables/{id}", func(w http.ResponseWriter, r *http.Request) {
+		table, ok := s.lookupTables[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "lookup table not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, table)
+	})
+	mux.HandleFunc("PUT /lookup-tables/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		table, ok := s.lookupTables[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "lookup table not found")
+			return
+		}
+		in, ok := decode[client.LookupTableInput](w, r)
+		if !ok || !s.checkLookupTable(w, in, id) {
+			return
+		}
+		table.LookupTableInput = in
+		if in.Refresh != nil {
+			// Switching to an S3 refresh discards uploaded data.
+			table.DataHash = ""
+		}
+		s.lookupTables[id] = table
+		writeJSON(w, http.StatusOK, table)
+	})
+	mux.HandleFunc("DELETE /lookup-tables/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.lookupTables[id]; !ok {
+			writeError(w, http.StatusNotFound, "lookup table not found")
+			return
+		}
+		delete(s.lookupTables, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	// Uploading data replaces the table's rows; the stored hash lets clients detect
+	// whether their copy is the one loaded.
+	mux.HandleFunc("PUT /lookup-tables/{id}/data", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		table, ok := s.lookupTables[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "lookup table not found")
+			return
+		}
+		if table.Refresh != nil {
+			writeError(w, http.StatusBadRequest, "lookup table %q is refreshed from S3 and does not accept uploads", table.Name)
+			return
+		}
+		in, ok := decode[client.LookupTableDataInput](w, r)
+		if !ok {
+			return
+		}
+		if strings.TrimSpace(in.Data) == "" {
+			writeError(w, http.StatusBadRequest, "data must not be empty")
+			return
+		}
+		sum := sha256.Sum256([]byte(in.Data))
+		table.DataHash = hex.EncodeToString(sum[:])
+		s.lookupTables[id] = table
+		writeJSON(w, http.StatusOK, table)
+	})
+}
+
+// checkLookupTable writes a 400 for an invalid lookup table and a 409 if another table
+// already uses its name. Caller holds s.mu.
+func (s *Server) checkLookupTable(w http.ResponseWriter, in client.LookupTableInput, exceptID string) bool {
+	msg := ""
+	switch {
+	case in.Name == "" || in.PrimaryKey == "":
+		msg = "name and primaryKey are required"
+	case !s.logTypeExists(in.LogType):
+		msg = "unknown log type " + in.LogType
+	case in.Refresh != nil && !strings.HasPrefix(in.Refresh.S3ObjectPath, "s3://"):
+		msg = "refresh.s3ObjectPath must be an s3:// URL"
+	case in.Refresh != nil && in.Refresh.RoleARN == "":
+		msg = "refresh.roleArn is required"
+	case in.Refresh != nil && (in.Refresh.PeriodMinutes < 15 || in.Refresh.PeriodMinutes > 1440):
+		msg = "refresh.periodMinutes must be between 15 and 1440"
+	}
+	for _, association := range in.AssociatedLogTypes {
+		switch {
+		case msg != "":
+		case !s.logTypeExists(association.LogType):
+			msg = "unknown log type " + association.LogType
+		case len(association.Selectors) == 0:
+			msg = "associatedLogTypes " + association.LogType + ": selectors must not be empty"
+		}
+	}
+	if msg != "" {
+		writeError(w, http.StatusBadRequest, "%s", msg)
+		return false
+	}
+	for id, table := range s.lookupTables {
+		if id != exceptID && table.Name == in.Name {
+			writeError(w, http.StatusConflict, "a lookup table named %q already exists", in.Name)
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/http"
+	"strings"
+
+	"terraform-provider-panther/internal/client"
+)
+
+func (s *Server) registerQueries(mux *http.ServeMux) {
+	mux.HandleFunc("POST /saved-queries", func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[client.SavedQueryInput](w, r)
+		if !ok || !s.checkQuery(w, in.Name, in.SQL, "") {
+			return
+		}
+		in.SQL = storedSQL(in.SQL)
+		query := client.SavedQuery{ID: newID(), SavedQueryInput: in}
+		s.savedQueries[query.ID] = query
+		writeJSON(w, http.StatusCreated, query)
+	})
+	mux.HandleFunc("GET /saved-queries/{id}", func(w http.ResponseWriter, r *http.Request) {
+		query, ok := s.savedQueries[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "saved query not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, query)
+	})
+	mux.HandleFunc("PUT /saved-queries/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		query, ok := s.savedQueries[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "saved query not found")
+			return
+		}
+		in, ok := decode[client.SavedQueryInput](w, r)
+		if !ok || !s.checkQuery(w, in.Name, in.SQL, id) {
+			return
+		}
+		in.SQL = storedSQL(in.SQL)
+		query.SavedQueryInput = in
+		s.savedQueries[id] = query
+		writeJSON(w, http.StatusOK, query)
+	})
+	mux.HandleFunc("DELETE /saved-queries/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.savedQueries[id]; !ok {
+			writeError(w, http.StatusNotFound, "saved query not found")
+			return
+		}
+		delete(s.savedQueries, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("POST /scheduled-queries", func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[client.ScheduledQueryInput](w, r)
+		if !ok || !s.checkQuery(w, in.Name, in.SQL, "") || !checkSchedule(w, in) {
+			return
+		}
+		in.SQL = storedSQL(in.SQL)
+		query := client.ScheduledQuery{ID: newID(), ScheduledQueryInput: in}
+		s.scheduledQueries[query.ID] = query
+		writeJSON(w, http.StatusCreated, query)
+	})
+	mux.HandleFunc("GET /scheduled-queries/{id}", func(w http.ResponseWriter, r *http.Request) {
+		query, ok := s.scheduledQueries[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "scheduled query not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, query)
+	})
+	mux.HandleFunc("PUT /scheduled-queries/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		query, ok := s.scheduledQueries[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "scheduled query not found")
+			return
+		}
+		in, ok := decode[client.ScheduledQueryInput](w, r)
+		if !ok || !s.checkQuery(w, in.Name, in.SQL, id) || !checkSchedule(w, in) {
+			return
+		}
+		in.SQL = storedSQL(in.SQL)
+		query.ScheduledQueryInput = in
+		s.scheduledQueries[id] = query
+		writeJSON(w, http.StatusOK, query)
+	})
+	mux.HandleFunc("DELETE /scheduled-queries/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := s.scheduledQueries[id]; !ok {
+			writeError(w, http.StatusNotFound, "scheduled query not found")
+			return
+		}
+		delete(s.scheduledQueries, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// checkQuery writes a 400 for a query without a name or SQL, and a 409 if another saved
+// or scheduled query already uses the name. Caller holds s.mu.
+func (s *Server) checkQuery(w http.ResponseWriter, name, sql, exceptID string) bool {
+	if name == "" || strings.TrimSpace(sql) == "" {
+		writeError(w, http.StatusBadRequest, "name and sql are required")
+		return false
+	}
+	if s.queryNameTaken(name, exceptID) {
+		writeError(w, http.StatusConflict, "a query named %q already exists", name)
+		return false
+	}
+	return true
+}
+
+// queryNameTaken reports whether another query uses name. Names are unique across saved
+// and scheduled queries. Caller holds s.mu.
+func (s *Server) queryNameTaken(name, exceptID string) bool {
+	for id, q := range s.savedQueries {
+		if id != exceptID && q.Name == name {
+			return true
+		}
+	}
+	for id, q := range s.scheduledQueries {
+		if id != exceptID && q.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// scheduledQueryExists reports whether a scheduled query is named name. Caller holds s.mu.
+func (s *Server) scheduledQueryExists(name string) bool {
+	for _, q := range s.scheduledQueries {
+		if q.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func checkSchedule(w http.ResponseWriter, in client.ScheduledQueryInput) bool {
+	switch {
+	case (in.CronExpression == "") == (in.RateMinutes == 0):
+		writeError(w, http.StatusBadRequest, "exactly one of cronExpression and rateMinutes is required")
+	case in.CronExpression != "" && len(strings.Fields(in.CronExpression)) != 5:
+		writeError(w, http.StatusBadRequest, "cronExpression must have 5 fields")
+	case in.RateMinutes < 0:
+		writeError(w, http.StatusBadRequest, "rateMinutes must be positive")
+	case in.TimeoutMinutes < 1 || in.TimeoutMinutes > 15:
+		writeError(w, http.StatusBadRequest, "timeoutMinutes must be between 1 and 15")
+	default:
+		return true
+	}
+	return false
+}
+
+// storedSQL mimics the API normalizing the SQL it stores: line endings become \n and
+// trailing whitespace is dropped from every line.
+func storedSQL(sql string) string {
+	lines := strings.Split(strings.ReplaceAll(sql, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"terraform-provider-panther/internal/client"
+)
+
+// RejectedCredential is a credential every fake SaaS puller treats as refused by the
+// third party, to exercise the API's 400 rejectedCredentials response.
+const RejectedCredential = "rejected-credential"
+
+// saasSource is a stored SaaS puller of any kind. body is the client.<Kind>Source as
+// last saved, credentials included.
+type saasSource struct {
+	kind  string
+	label string
+	body  any
+}
+
+// saasKind describes one SaaS puller endpoint, /log-sources/<path>.
+type saasKind[T any] struct {
+	path     string
+	vendor   string
+	logTypes []string
+	core     func(*T) *client.SaasSourceCore
+	// credentials returns the source's credential fields by JSON name.
+	credentials func(*T) map[string]*string
+	// validate returns a 400 message for a body missing required fields, or "".
+	validate func(T) string
+}
+
+func (s *Server) registerSaaS(mux *http.ServeMux) {
+	registerSaaSKind(s, mux, saasKind[client.OktaSource]{
+		path: "okta", vendor: "Okta", logTypes: []string{"Okta.SystemLog"},
+		core:        func(src *client.OktaSource) *client.SaasSourceCore { return &src.SaasSourceCore },
+		credentials: func(src *client.OktaSource) map[string]*string { return map[string]*string{"apiToken": &src.ApiToken} },
+		validate: func(src client.OktaSource) string {
+			return requireFields("domain", src.Domain)
+		},
+	})
+	registerSaaSKind(s, mux, saasKind[client.GitHubSource]{
+		path: "github", vendor: "GitHub", logTypes: []string{"GitHub.Audit"},
+		core: func(src *client.GitHubSource) *client.SaasSourceCore { return &src.SaasSourceCore },
+		credentials: func(src *client.GitHubSource) map[string]*string {
+			return map[string]*string{"personalAccessToken": &src.PersonalAccessToken}
+		},
+		validate: func(src client.GitHubSource) string {
+			return requireFields("organization", src.Organization)
+		},
+	})
+	registerSaaSKind(s, mux, saasKind[client.SlackSource]{
+		path: "slack", vendor: "Slack", logTypes: []string{"Slack.AuditLogs"},
+		core: func(src *client.SlackSource) *client.SaasSourceCore { return &src.SaasSourceCore },
+		credentials: func(src *client.SlackSource) map[string]*string {
+			return map[string]*string{"accessToken": &src.AccessToken}
+		},
+		validate: func(src client.SlackSource) string {
+			return requireFields("enterpriseId", src.EnterpriseId)
+		},
+	})
+	registerSaaSKind(s, mux, saasKind[client.GoogleWorkspaceSource]{
+		path: "google-workspace", vendor: "Google", logTypes: []string{"GSuite.Reports"},
+		core: func(src *client.GoogleWorkspaceSource) *client.SaasSourceCore { return &src.SaasSourceCore },
+		credentials: func(src *client.GoogleWorkspaceSource) map[string]*string {
+			return map[string]*string{"serviceAccountKey": &src.ServiceAccountKey}
+		},
+		validate: func(src client.GoogleWorkspaceSource) string {
+			if msg := requireFields("customerId", src.CustomerId, "adminEmail", src.AdminEmail); msg != "" {
+				return msg
+			}
+			if src.ServiceAccountKey != "" && src.ServiceAccountKey != RejectedCredential && !json.Valid([]byte(src.ServiceAccountKey)) {
+				return "serviceAccountKey must be a JSON key file"
+			}
+			return ""
+		},
+	})
+	registerSaaSKind(s, mux, saasKind[client.OnePasswordSource]{
+		path: "onepassword", vendor: "1Password",
+		logTypes: []string{"OnePassword.AuditEvent", "OnePassword.ItemUsage", "OnePassword.SignInAttempt"},
+		core:     func(src *client.OnePasswordSource) *client.SaasSourceCore { return &src.SaasSourceCore },
+		credentials: func(src *client.OnePasswordSource) map[string]*string {
+			return map[string]*string{"bearerToken": &src.BearerToken}
+		},
+		validate: func(src client.OnePasswordSource) string {
+			return requireFields("eventsApiHost", src.EventsApiHost)
+		},
+	})
+	registerSaaSKind(s, mux, saasKind[client.Microsoft365Source]{
+		path: "microsoft365", vendor: "Microsoft Entra ID",
+		logTypes: []string{
+			"Microsoft365.Audit.AzureActiveDirectory", "Microsoft365.Audit.Exchange",
+			"Microsoft365.Audit.General", "Microsoft365.Audit.SharePoint",
+		},
+		core: func(src *client.Microsoft365Source) *client.SaasSourceCore { return &src.SaasSourceCore },
+		credentials: func(src *client.Microsoft365Source) map[string]*string {
+			return map[string]*string{"clientSecret": &src.ClientSecret}
+		},
+		validate: func(src client.Microsoft365Source) string {
+			if !azureGUIDRegex.MatchString(src.TenantId) || !azureGUIDRegex.MatchString(src.ClientId) {
+				return "tenantId and clientId must be GUIDs"
+			}
+			return ""
+		},
+	})
+}
+
+// requireFields takes name, value pairs and returns a 400 message naming the first
+// empty field, or "".
+func requireFields(pairs ...string) string {
+	for i := 0; i < len(pairs); i += 2 {
+		if pairs[i+1] == "" {
+			return pairs[i] + " is required"
+		}
+	}
+	return ""
+}
+
+func registerSaaSKind[T any](s *Server, mux *http.ServeMux, k saasKind[T]) {
+	base := "/log-sources/" + k.path
+	notFound := k.path + " source not found"
+	get := func(id string) (T, bool) {
+		src, ok := s.saas[id]
+		if !ok || src.kind != k.path {
+			var zero T
+			return zero, false
+		}
+		return src.body.(T), true
+	}
+	redact := func(src T) T {
+		for _, value := range k.credentials(&src) {
+			*value = ""
+		}
+		return src
+	}
+	save := func(w http.ResponseWriter, id string, in T, previous *T, status int) {
+		core := k.core(&in)
+		core.IntegrationId = id
+		core.LogTypes = k.logTypes
+		if core.IntegrationLabel == "" {
+			writeError(w, http.StatusBadRequest, "integrationLabel is required")
+			return
+		}
+		if msg := k.validate(in); msg != "" {
+			writeError(w, http.StatusBadRequest, "%s", msg)
+			return
+		}
+		for name, value := range k.credentials(&in) {
+			if *value == "" && previous != nil {
+				*value = *k.credentials(previous)[name]
+			}
+			if *value == "" {
+				writeError(w, http.StatusBadRequest, "%s is required", name)
+				return
+			}
+			if *value == RejectedCredential {
+				writeJSON(w, http.StatusBadRequest, map[string]any{
+					"message":             k.vendor + " refused the credentials: 401 Unauthorized",
+					"rejectedCredentials": []string{name},
+				})
+				return
+			}
+		}
+		if s.labelTaken(core.IntegrationLabel, id) {
+			writeError(w, http.StatusConflict, "a log source with label %q already exists", core.IntegrationLabel)
+			return
+		}
+		s.saas[id] = saasSource{kind: k.path, label: core.IntegrationLabel, body: in}
+		writeJSON(w, status, redact(in))
+	}
+
+	mux.HandleFunc("POST "+base, func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[T](w, r)
+		if !ok {
+			return
+		}
+		save(w, newID(), in, nil, http.StatusCreated)
+	})
+	mux.HandleFunc("GET "+base, func(w http.ResponseWriter, r *http.Request) {
+		sources := map[string]T{}
+		for id, src := range s.saas {
+			if src.kind == k.path {
+				sources[id] = src.body.(T)
+			}
+		}
+		writePage(w, r, s.PageSize, sources, redact)
+	})
+	mux.HandleFunc("GET "+base+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		src, ok := get(r.PathValue("id"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "%s", notFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, redact(src))
+	})
+	mux.HandleFunc("PUT "+base+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		existing, ok := get(id)
+		if !ok {
+			writeError(w, http.StatusNotFound, "%s", notFound)
+			return
+		}
+		in, ok := decode[T](w, r)
+		if !ok {
+			return
+		}
+		save(w, id, in, &existing, http.StatusOK)
+	})
+	mux.HandleFunc("DELETE "+base+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := get(id); !ok {
+			writeError(w, http.StatusNotFound, "%s", notFound)
+			return
+		}
+		delete(s.saas, id)
+		s.deleteSource(id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"terraform-provider-panther/internal/client"
+
+	"gopkg.in/yaml.v3"
+)
+
+// managedLogTypes is the fake instance's catalog of Panther-managed schemas. It covers
+// the log types the acceptance tests and examples reference.
+var managedLogTypes = []string{
+	"AWS.ALB",
+	"AWS.CloudFrontAccess",
+	"AWS.CloudTrail",
+	"AWS.GuardDuty",
+	"AWS.S3ServerAccess",
+	"AWS.VPCFlow",
+	"GCP.AuditLog",
+	"GCP.HTTPLoadBalancer",
+	"GitHub.Audit",
+	"GSuite.Reports",
+	"Microsoft365.Audit.AzureActiveDirectory",
+	"Microsoft365.Audit.Exchange",
+	"Microsoft365.Audit.General",
+	"Microsoft365.Audit.SharePoint",
+	"Okta.SystemLog",
+	"OnePassword.AuditEvent",
+	"OnePassword.ItemUsage",
+	"OnePassword.SignInAttempt",
+	"Slack.AuditLogs",
+	"Zscaler.ZIA.WebLog",
+}
+
+// PutSchema adds or replaces a schema in the fake catalog, e.g. a custom or archived one.
+func (s *Server) PutSchema(schema client.Schema) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.schemas[schema.Name] = schema
+}
+
+func (s *Server) registerSchemas(mux *http.ServeMux) {
+	for _, name := range managedLogTypes {
+		s.schemas[name] = client.Schema{Name: name, IsManaged: true}
+	}
+	mux.HandleFunc("GET /schemas", func(w http.ResponseWriter, r *http.Request) {
+		writePage(w, r, s.PageSize, s.schemas, func(schema client.Schema) client.Schema {
+			schema.Spec = ""
+			return schema
+		})
+	})
+	mux.HandleFunc("POST /schemas", func(w http.ResponseWriter, r *http.Request) {
+		in, ok := decode[client.SchemaInput](w, r)
+		if !ok {
+			return
+		}
+		if _, exists := s.schemas[in.Name]; exists {
+			writeError(w, http.StatusConflict, "schema %q already exists", in.Name)
+			return
+		}
+		if !strings.HasPrefix(in.Name, "Custom.") {
+			writeError(w, http.StatusBadRequest, "schema name %q must start with \"Custom.\"", in.Name)
+			return
+		}
+		s.putCustomSchema(w, in.Name, in)
+	})
+	mux.HandleFunc("GET /schemas/{name}", func(w http.ResponseWriter, r *http.Request) {
+		schema, ok := s.schemas[r.PathValue("name")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "schema not found")
+			return
+		}
+		writeJSON(w, http.StatusOK, schema)
+	})
+	mux.HandleFunc("PUT /schemas/{name}", func(w http.ResponseWriter, r *http.Request) {
+		name := r.PathValue("name")
+		existing, ok := s.schemas[name]
+		if !ok {
+			writeError(w, http.StatusNotFound, "schema not found")
+			return
+		}
+		if existing.IsManaged {
+			writeError(w, http.StatusBadRequest, "schema %q is managed by Panther and can't be modified", name)
+			return
+		}
+		in, ok := decode[client.SchemaInput](w, r)
+		if !ok {
+			return
+		}
+		s.putCustomSchema(w, name, in)
+	})
+}
+
+// putCustomSchema validates and stores a custom schema, echoing it back with the spec
+// re-serialized the way the API does (so clients must compare specs semantically).
+// Caller holds s.mu.
+func (s *Server) putCustomSchema(w http.ResponseWriter, name string, in client.SchemaInput) {
+	spec, err := normalizeSpec(in.Spec)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "schema validation failed: %s", err)
+		return
+	}
+	schema := client.Schema{
+		Name:         name,
+		Description:  in.Description,
+		ReferenceURL: in.ReferenceURL,
+		Spec:         spec,
+		IsArchived:   in.IsArchived,
+	}
+	s.schemas[name] = schema
+	writeJSON(w, http.StatusOK, schema)
+}
+
+// normalizeSpec checks the minimum the real API requires of a spec, a non-empty
+// fields list whose entries have a name and a type, and re-marshals it.
+func normalizeSpec(spec string) (string, error) {
+	var parsed struct {
+		Fields []map[string]any `yaml:"fields"`
+	}
+	if err := yaml.Unmarshal([]byte(spec), &parsed); err != nil {
+		return "", fmt.Errorf("spec is not valid YAML: %w", err)
+	}
+	if len(parsed.Fields) == 0 {
+		return "", fmt.Errorf("spec must define at least one field")
+	}
+	for i, field := range parsed.Fields {
+		for _, key := range []string{"name", "type"} {
+			if _, ok := field[key]; !ok {
+				return "", fmt.Errorf("fields[%d]: %s is required", i, key)
+			}
+		}
+	}
+	var generic any
+	_ = yaml.Unmarshal([]byte(spec), &generic)
+	out, err := yaml.Marshal(generic)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// logTypeExists reports whether name is an active schema. Caller holds s.mu.
+func (s *Server) logTypeExists(name string) bool {
+	schema, ok := s.schemas[name]
+	return ok && !schema.IsArchived
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake serves a stateful, in-memory imitation of the Panther REST API
+// over httptest.Server, so acceptance tests can run without a live instance or
+// credentials. It reuses the client package's request and response types, and
+// reproduces the API behaviors the provider depends on: 401 for a bad token, 404
+// for unknown IDs, 409 for duplicate labels/subscriptions/accounts, blanked
+// sensitive fields on read, and server-derived fields such as credentialsType.
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/google/uuid"
+)
+
+// DefaultPageSize is how many results a list endpoint returns per page unless
+// the request sets ?limit.
+const DefaultPageSize = 25
+
+// Server is a running fake Panther API. URL is the base URL to hand to the
+// provider (or client.NewRESTClient); Token is the only API key it accepts.
+type Server struct {
+	*httptest.Server
+	Token    string
+	PageSize int // results per list page; lower it to exercise pagination
+
+	mu            sync.Mutex
+	s3            map[string]client.S3Source
+	http          map[string]client.HttpSource
+	gcs           map[string]client.GcsSource
+	pubsub        map[string]client.PubSubSource
+	sqs           map[string]client.SqsSource
+	azureBlob     map[string]client.AzureBlobSource
+	azureEventHub map[string]client.AzureEventHubSource
+	cloudWatch    map[string]client.CloudWatchSource
+	eventBridge   map[string]client.EventBridgeSource
+	saas          map[string]saasSource
+	alarms        map[string]map[string]client.LogSourceAlarm // sourceId → alarm type → alarm
+	alarmStates   map[string]map[string]string                // sourceId → alarm type → runtime state
+	awsAccounts   map[string]client.AwsCloudAccount
+	schemas       map[string]client.Schema // keyed by name
+
+	rules          map[string]client.Rule
+	scheduledRules map[string]client.ScheduledRule
+	policies       map[string]client.Policy
+	globalHelpers  map[string]client.GlobalHelper
+	dataModels     map[string]client.DataModel
+
+	savedQueries     map[string]client.SavedQuery
+	scheduledQueries map[string]client.ScheduledQuery
+
+	slackDestinations     map[string]client.SlackDestination
+	pagerDutyDestinations map[string]client.PagerDutyDestination
+	webhookDestinations   map[string]client.WebhookDestination
+	snsDestinations       map[string]client.SNSDestination
+	jiraDestinations      map[string]client.JiraDestination
+	opsgenieDestinations  map[string]client.OpsgenieDestination
+
+	lookupTables map[string]client.LookupTable
+
+	roles     map[string]client.Role
+	users     map[string]client.User
+	apiTokens map[string]client.APIToken
+}
+
+// NewServer starts a fake API that accepts token as its only valid X-API-Key.
+// Callers must Close it.
+func NewServer(token string) *Server {
+	s := &Server{
+		Token:         token,
+		PageSize:      DefaultPageSize,
+		s3:            map[string]client.S3Source{},
+		http:          map[string]client.HttpSource{},
+		gcs:           map[string]client.GcsSource{},
+		pubsub:        map[string]client.PubSubSource{},
+		sqs:           map[string]client.SqsSource{},
+		azureBlob:     map[string]client.AzureBlobSource{},
+		azureEventHub: map[string]client.AzureEventHubSource{},
+		cloudWatch:    map[string]client.CloudWatchSource{},
+		eventBridge:   map[string]client.EventBridgeSource{},
+		saas:          map[string]saasSource{},
+		alarms:        map[string]map[string]client.LogSourceAlarm{},
+		alarmStates:   map[string]map[string]string{},
+		awsAccounts:   map[string]client.AwsCloudAccount{},
+		schemas:       map[string]client.Schema{},
+
+		rules:          map[string]client.Rule{},
+		scheduledRules: map[string]client.ScheduledRule{},
+		policies:       map[string]client.Policy{},
+		globalHelpers:  map[string]client.GlobalHelper{},
+		dataModels:     map[string]client.DataModel{},
+
+		savedQueries:     map[string]client.SavedQuery{},
+		scheduledQueries: map[string]client.ScheduledQuery{},
+
+		slackDestinations:     map[string]client.SlackDestination{},
+		pagerDutyDestinations: map[string]client.PagerDutyDestination{},
+		webhookDestinations:   map[string]client.WebhookDestination{},
+		snsDestinations:       map[string]client.SNSDestination{},
+		jiraDestinations:      map[string]client.JiraDestination{},
+		opsgenieDestinations:  map[string]client.OpsgenieDestination{},
+
+		lookupTables: map[string]client.LookupTable{},
+
+		roles:     map[string]client.Role{},
+		users:     map[string]client.User{},
+		apiTokens: map[string]client.APIToken{},
+	}
+	mux := http.NewServeMux()
+	s.registerS3(mux)
+	s.registerHTTP(mux)
+	s.registerGCS(mux)
+	s.registerPubSub(mux)
+	s.registerSQS(mux)
+	s.registerAzureBlob(mux)
+	s.registerAzureEventHub(mux)
+	s.registerCloudWatch(mux)
+	s.registerEventBridge(mux)
+	s.registerSaaS(mux)
+	s.registerAlarms(mux)
+	s.registerAwsCloudAccounts(mux)
+	s.registerSchemas(mux)
+	s.registerDetections(mux)
+	s.registerDataModels(mux)
+	s.registerQueries(mux)
+	s.registerDestinations(mux)
+	s.registerLookupTables(mux)
+	s.registerAccess(mux)
+	s.Server = httptest.NewServer(s.authenticate(mux))
+	return s
+}
+
+// authenticate rejects requests without the expected API key, like the real gateway.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-API-Key") != s.Token {
+			writeError(w, http.StatusUnauthorized, "invalid or missing API token")
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// sourceExists reports whether id names a log source of any type. Caller holds s.mu.
+func (s *Server) sourceExists(id string) bool {
+	_, s3 := s.s3[id]
+	_, h := s.http[id]
+	_, g := s.gcs[id]
+	_, p := s.pubsub[id]
+	_, q := s.sqs[id]
+	_, ab := s.azureBlob[id]
+	_, ae := s.azureEventHub[id]
+	_, cw := s.cloudWatch[id]
+	_, eb := s.eventBridge[id]
+	_, ss := s.saas[id]
+	return s3 || h || g || p || q || ab || ae || cw || eb || ss
+}
+
+// labelTaken reports whether another log source already uses label. Integration
+// labels are unique across all log source types. Caller holds s.mu.
+func (s *Server) labelTaken(label, exceptID string) bool {
+	taken := func(id, other string) bool { return id != exceptID && other == label }
+	for id, src := range s.s3 {
+		if taken(id, src.IntegrationLabel) {
+			return true
+		}
+	}
+	for id, src := range s.http {
+		if taken(id, src.IntegrationLabel) {
+			return true
+		}
+	}
+	for id, src := range s.gcs {
+		if taken(id, src.IntegrationLabel) {
+			return true
+		}
+	}
+	for id, src := range s.pubsub {
+		if taken(id, src.IntegrationLabel) {
+			return true
+		}
+	}
+	for id, src := range s.sqs {
+		if taken(id, src.IntegrationLabel) {
+			return true
+		}
+	}
+	for id, src := range s.azureBlob {
+		if taken(id, src.IntegrationLabel) {
+			return true
+		}
+	}
+	for id, src := range s.azureEventHub {
+		if taken(id, src.IntegrationLabel) {
+			return true
+		}
+	}
+	for id, src := range s.cloudWatch {
+		if taken(id, src.IntegrationLabel) {
+			return true
+		}
+	}
+	for id, src := range s.eventBridge {
+		if taken(id, src.IntegrationLabel) {
+			return true
+		}
+	}
+	for id, src := range s.saas {
+		if taken(id, src.label) {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteSource removes a log source's alarms and their states along with it. Caller holds s.mu.
+func (s *Server) deleteSource(id string) {
+	delete(s.alarms, id)
+	delete(s.alarmStates, id)
+}
+
+// writePage serves items as one page of a list endpoint, in the API's
+// {"results": [...], "next": "<cursor>"} envelope. Items are ordered by ID so
+// the opaque cursor (an offset) is stable across requests. Caller holds s.mu.
+func writePage[T any](w http.ResponseWriter, r *http.Request, pageSize int, items map[string]T, redact func(T) T) {
+	if limit, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && limit > 0 {
+		pageSize = limit
+	}
+	offset := 0
+	if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+		var err error
+		if offset, err = strconv.Atoi(cursor); err != nil || offset < 0 {
+			writeError(w, http.StatusBadRequest, "invalid cursor %q", cursor)
+			return
+		}
+	}
+
+	ids := make([]string, 0, len(items))
+	for id := range items {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	page := client.ListResponse[T]{Results: []T{}}
+	for i := offset; i < len(ids) && i < offset+pageSize; i++ {
+		item := items[ids[i]]
+		if redact != nil {
+			item = redact(item)
+		}
+		page.Results = append(page.Results, item)
+	}
+	if offset+pageSize < len(ids) {
+		page.Next = strconv.Itoa(offset + pageSize)
+	}
+	writeJSON(w, http.StatusOK, page)
+}
+
+func newID() string {
+	return uuid.NewString()
+}
+
+// decode unmarshals the request body, writing a 400 on malformed JSON.
+func decode[T any](w http.ResponseWriter, r *http.Request) (T, bool) {
+	var v T
+	if err := json.NewDecoder(r.Body).Decode(&v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: %s", err)
+		return v, false
+	}
+	return v, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError mirrors the API's {"message": "..."} error envelope.
+func writeError(w http.ResponseWriter, status int, format string, args ...any) {
+	writeJSON(w, status, map[string]string{"message": fmt.Sprintf(format, args...)})
+}
@@ -0,0 +1,670 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testToken = "fake-token"
+
+func newTestServer(t *testing.T) (*Server, *client.RESTClient) {
+	t.Helper()
+	srv := NewServer(testToken)
+	t.Cleanup(srv.Close)
+	c := client.NewRESTClient(srv.URL, testToken, "ua")
+	c.Retry = client.RetryPolicy{}
+	return srv, c
+}
+
+func TestServer_RejectsBadToken(t *testing.T) {
+	srv, _ := newTestServer(t)
+	c := client.NewRESTClient(srv.URL, "wrong-token", "ua")
+
+	_, err := client.RestDo[client.HttpSource](context.Background(), c, http.MethodGet, "/log-sources/http/any", nil)
+	assert.True(t, client.IsUnauthorized(err))
+}
+
+func TestServer_HttpSourceLifecycle(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	created, err := client.RestDo[client.HttpSource](ctx, c, http.MethodPost, "/log-sources/http", client.HttpSourceInput{
+		IntegrationLabel: "web",
+		LogStreamType:    "JSON",
+		LogTypes:         []string{"AWS.CloudTrail"},
+		AuthMethod:       "Bearer",
+		AuthBearerToken:  "s3cr3t",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.IntegrationId)
+	assert.Empty(t, created.AuthBearerToken, "secrets are never echoed back")
+
+	got, err := client.RestDo[client.HttpSource](ctx, c, http.MethodGet, "/log-sources/http/"+created.IntegrationId, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "web", got.IntegrationLabel)
+	assert.Equal(t, "Bearer", got.AuthMethod)
+	assert.Empty(t, got.AuthBearerToken)
+
+	_, err = client.RestDo[client.HttpSource](ctx, c, http.MethodPost, "/log-sources/http", client.HttpSourceInput{
+		IntegrationLabel: "web",
+		LogTypes:         []string{"AWS.CloudTrail"},
+		AuthMethod:       "None",
+	})
+	assert.True(t, client.IsConflict(err), "labels are unique")
+
+	require.NoError(t, client.RestDelete(ctx, c, "/log-sources/http/"+created.IntegrationId))
+	_, err = client.RestDo[client.HttpSource](ctx, c, http.MethodGet, "/log-sources/http/"+created.IntegrationId, nil)
+	assert.True(t, client.IsNotFound(err))
+	assert.True(t, client.IsNotFound(client.RestDelete(ctx, c, "/log-sources/http/"+created.IntegrationId)))
+}
+
+func TestServer_HttpSourceRequiresAuthSecrets(t *testing.T) {
+	_, c := newTestServer(t)
+
+	_, err := client.RestDo[client.HttpSource](context.Background(), c, http.MethodPost, "/log-sources/http", client.HttpSourceInput{
+		IntegrationLabel: "web", LogTypes: []string{"AWS.CloudTrail"}, AuthMethod: "Basic", AuthUsername: "user",
+	})
+	var apiErr *client.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	assert.Contains(t, apiErr.Message, "authPassword")
+}
+
+func TestServer_LabelsUniqueAcrossSourceTypes(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	_, err := client.RestDo[client.HttpSource](ctx, c, http.MethodPost, "/log-sources/http", client.HttpSourceInput{
+		IntegrationLabel: "shared", LogTypes: []string{"AWS.CloudTrail"}, AuthMethod: "None",
+	})
+	require.NoError(t, err)
+	_, err = client.RestDo[client.S3Source](ctx, c, http.MethodPost, "/log-sources/s3", client.S3SourceCreateInput{
+		IntegrationLabel: "shared", AwsAccountId: "123456789012", S3Bucket: "b", LogProcessingRole: "r",
+	})
+	assert.True(t, client.IsConflict(err))
+	_, err = client.RestDo[client.SqsSource](ctx, c, http.MethodPost, "/log-sources/sqs", client.SqsSourceInput{
+		IntegrationLabel: "shared", LogTypes: []string{"AWS.CloudTrail"},
+	})
+	assert.True(t, client.IsConflict(err))
+}
+
+func TestServer_SqsSourceQueueURL(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	in := client.SqsSourceInput{IntegrationLabel: "sqs", LogTypes: []string{"AWS.CloudTrail"}}
+	created, err := client.RestDo[client.SqsSource](ctx, c, http.MethodPost, "/log-sources/sqs", in)
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.QueueUrl)
+
+	in.AllowedSourceArns = []string{"arn:aws:sns:us-east-1:123456789012:app-logs"}
+	updated, err := client.RestDo[client.SqsSource](ctx, c, http.MethodPut, "/log-sources/sqs/"+created.IntegrationId, in)
+	require.NoError(t, err)
+	assert.Equal(t, created.QueueUrl, updated.QueueUrl, "queue is kept across updates")
+
+	in.AllowedPrincipalArns = []string{"123456789012"}
+	_, err = client.RestDo[client.SqsSource](ctx, c, http.MethodPut, "/log-sources/sqs/"+created.IntegrationId, in)
+	assert.True(t, client.IsBadRequest(err), "principals must be ARNs")
+}
+
+func TestServer_AzureSources(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+	const tenant, app = "72f988bf-86f1-41af-91ab-2d7cd011db47", "0b4f2c1e-6d7a-4c3b-9e8f-1a2b3c4d5e6f"
+
+	blobIn := client.AzureBlobSourceInput{
+		IntegrationLabel: "blob", TenantId: tenant, ClientId: app, StorageAccountName: "pantherlogs",
+		ContainerName: "audit", LogStreamType: "JSON",
+		PrefixLogTypes: []client.AzurePrefixLogTypesInput{{LogTypes: []string{"AWS.CloudTrail"}}},
+	}
+	_, err := client.RestDo[client.AzureBlobSource](ctx, c, http.MethodPost, "/log-sources/azure-blob", blobIn)
+	assert.True(t, client.IsBadRequest(err), "a new source needs a client secret")
+
+	blobIn.ClientSecret = "secret"
+	blob, err := client.RestDo[client.AzureBlobSource](ctx, c, http.MethodPost, "/log-sources/azure-blob", blobIn)
+	require.NoError(t, err)
+	assert.Empty(t, blob.ClientSecret, "the secret is never returned")
+
+	// Omitting the secret on update keeps the stored one.
+	blobIn.ClientSecret = ""
+	blobIn.IntegrationLabel = "blob-renamed"
+	_, err = client.RestDo[client.AzureBlobSource](ctx, c, http.MethodPut, "/log-sources/azure-blob/"+blob.IntegrationId, blobIn)
+	require.NoError(t, err)
+
+	blobIn.IntegrationLabel, blobIn.ClientSecret = "blob-dup", "secret"
+	_, err = client.RestDo[client.AzureBlobSource](ctx, c, http.MethodPost, "/log-sources/azure-blob", blobIn)
+	assert.True(t, client.IsConflict(err), "one integration per container")
+
+	hubIn := client.AzureEventHubSourceInput{
+		IntegrationLabel: "hub", TenantId: tenant, ClientId: app, ClientSecret: "secret", Namespace: "panther-logs",
+		EventHubName: "okta", LogTypes: []string{"Okta.SystemLog"}, LogStreamType: "JSON",
+	}
+	hub, err := client.RestDo[client.AzureEventHubSource](ctx, c, http.MethodPost, "/log-sources/azure-eventhub", hubIn)
+	require.NoError(t, err)
+	assert.Equal(t, "$Default", hub.ConsumerGroup)
+
+	hubIn.IntegrationLabel = "hub-dup"
+	_, err = client.RestDo[client.AzureEventHubSource](ctx, c, http.MethodPost, "/log-sources/azure-eventhub", hubIn)
+	assert.True(t, client.IsConflict(err), "readers on one consumer group would split the events")
+
+	hubIn.ConsumerGroup = "panther"
+	_, err = client.RestDo[client.AzureEventHubSource](ctx, c, http.MethodPost, "/log-sources/azure-eventhub", hubIn)
+	require.NoError(t, err)
+
+	hubIn.IntegrationLabel, hubIn.TenantId = "hub-bad", "contoso.onmicrosoft.com"
+	_, err = client.RestDo[client.AzureEventHubSource](ctx, c, http.MethodPost, "/log-sources/azure-eventhub", hubIn)
+	assert.True(t, client.IsBadRequest(err), "tenant must be a GUID")
+}
+
+func TestServer_AwsNativeSources(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	cwIn := client.CloudWatchSourceInput{
+		IntegrationLabel: "cw", AwsAccountId: "123456789012", Region: "us-east-1", LogGroupName: "/aws/lambda/app",
+		ManagedSubscription: true, LogProcessingRole: "arn:aws:iam::123456789012:role/panther", LogTypes: []string{"AWS.VPCFlow"},
+	}
+	cw, err := client.RestDo[client.CloudWatchSource](ctx, c, http.MethodPost, "/log-sources/cloudwatch", cwIn)
+	require.NoError(t, err)
+
+	cwIn.IntegrationLabel = "cw-dup"
+	_, err = client.RestDo[client.CloudWatchSource](ctx, c, http.MethodPost, "/log-sources/cloudwatch", cwIn)
+	assert.True(t, client.IsConflict(err), "one source per log group")
+
+	cwIn.IntegrationLabel, cwIn.LogGroupName = "cw", "/aws/lambda/other"
+	_, err = client.RestDo[client.CloudWatchSource](ctx, c, http.MethodPut, "/log-sources/cloudwatch/"+cw.IntegrationId, cwIn)
+	assert.True(t, client.IsBadRequest(err), "the log group can't be changed")
+
+	ebIn := client.EventBridgeSourceInput{
+		IntegrationLabel: "eb", AwsAccountId: "123456789012", Region: "us-east-1", BusName: "default",
+		LogTypes: []string{"AWS.GuardDuty"},
+	}
+	eb, err := client.RestDo[client.EventBridgeSource](ctx, c, http.MethodPost, "/log-sources/eventbridge", ebIn)
+	require.NoError(t, err)
+
+	ebIn.LogTypes = append(ebIn.LogTypes, "Okta.SystemLog")
+	updated, err := client.RestDo[client.EventBridgeSource](ctx, c, http.MethodPut, "/log-sources/eventbridge/"+eb.IntegrationId, ebIn)
+	require.NoError(t, err)
+	assert.Len(t, updated.LogTypes, 2)
+
+	ebIn.IntegrationLabel, ebIn.BusName = "cw", "other"
+	_, err = client.RestDo[client.EventBridgeSource](ctx, c, http.MethodPost, "/log-sources/eventbridge", ebIn)
+	assert.True(t, client.IsConflict(err), "labels are unique across source types")
+}
+
+func TestServer_SaaSSources(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	oktaIn := client.OktaSource{
+		SaasSourceCore: client.SaasSourceCore{IntegrationLabel: "okta"},
+		Domain:         "example.okta.com", PullIntervalMinutes: 5,
+	}
+	_, err := client.RestDo[client.OktaSource](ctx, c, http.MethodPost, "/log-sources/okta", oktaIn)
+	assert.True(t, client.IsBadRequest(err), "a new source needs an API token")
+
+	oktaIn.ApiToken = RejectedCredential
+	_, err = client.RestDo[client.OktaSource](ctx, c, http.MethodPost, "/log-sources/okta", oktaIn)
+	assert.Equal(t, []string{"apiToken"}, client.RejectedCredentials(err))
+
+	oktaIn.ApiToken = "token"
+	okta, err := client.RestDo[client.OktaSource](ctx, c, http.MethodPost, "/log-sources/okta", oktaIn)
+	require.NoError(t, err)
+	assert.Empty(t, okta.ApiToken, "the token is never returned")
+	assert.Equal(t, []string{"Okta.SystemLog"}, okta.LogTypes, "Panther picks the log types")
+
+	// Omitting the token on update keeps the stored one.
+	oktaIn.ApiToken = ""
+	oktaIn.PullIntervalMinutes = 10
+	okta, err = client.RestDo[client.OktaSource](ctx, c, http.MethodPut, "/log-sources/okta/"+okta.IntegrationId, oktaIn)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), okta.PullIntervalMinutes)
+
+	// Each kind has its own path, so an Okta ID isn't a GitHub source.
+	_, err = client.RestDo[client.GitHubSource](ctx, c, http.MethodGet, "/log-sources/github/"+okta.IntegrationId, nil)
+	assert.True(t, client.IsNotFound(err))
+
+	gitHubIn := client.GitHubSource{
+		SaasSourceCore: client.SaasSourceCore{IntegrationLabel: "okta"},
+		Organization:   "panther-labs", PersonalAccessToken: "ghp_token", PullIntervalMinutes: 5,
+	}
+	_, err = client.RestDo[client.GitHubSource](ctx, c, http.MethodPost, "/log-sources/github", gitHubIn)
+	assert.True(t, client.IsConflict(err), "labels are unique across every kind of source")
+
+	sources, err := client.RestList[client.OktaSource](ctx, c, "/log-sources/okta")
+	require.NoError(t, err)
+	require.Len(t, sources, 1)
+	assert.Empty(t, sources[0].ApiToken)
+}
+
+func TestServer_S3SourceKeepsImmutableFields(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	created, err := client.RestDo[client.S3Source](ctx, c, http.MethodPost, "/log-sources/s3", client.S3SourceCreateInput{
+		IntegrationLabel: "s3", AwsAccountId: "123456789012", S3Bucket: "bucket", LogProcessingRole: "role",
+		LogStreamType: "Lines",
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, created.LogStreamTypeOptions, "S3 returns {} for unset options")
+
+	updated, err := client.RestDo[client.S3Source](ctx, c, http.MethodPut, "/log-sources/s3/"+created.IntegrationId, client.S3SourceUpdateInput{
+		IntegrationLabel: "s3-renamed", LogProcessingRole: "role", LogStreamType: "JSON",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "s3-renamed", updated.IntegrationLabel)
+	assert.Equal(t, "bucket", updated.S3Bucket)
+	assert.Equal(t, "123456789012", updated.AwsAccountId)
+}
+
+func TestServer_GcpCredentialDerivation(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	sa, err := client.RestDo[client.PubSubSource](ctx, c, http.MethodPost, "/log-sources/pubsub", client.PubSubSourceInput{
+		IntegrationLabel: "ps", SubscriptionId: "sub-1", LogTypes: []string{"GCP.AuditLog"},
+		Credentials: `{"type":"service_account","project_id":"derived-project"}`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "service_account", sa.CredentialsType)
+	assert.Equal(t, "derived-project", sa.ProjectId)
+	assert.Empty(t, sa.Credentials)
+
+	wif, err := client.RestDo[client.GcsSource](ctx, c, http.MethodPost, "/log-sources/gcs", client.GcsSourceInput{
+		IntegrationLabel: "gcs", SubscriptionId: "sub-1", GcsBucket: "bucket", ProjectId: "p",
+		Credentials: `{"type":"external_account"}`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "wif", wif.CredentialsType)
+
+	// Omitting credentials on update keeps the stored ones.
+	_, err = client.RestDo[client.GcsSource](ctx, c, http.MethodPut, "/log-sources/gcs/"+wif.IntegrationId, client.GcsSourceInput{
+		IntegrationLabel: "gcs", SubscriptionId: "sub-1", GcsBucket: "bucket", ProjectId: "p",
+	})
+	require.NoError(t, err)
+
+	_, err = client.RestDo[client.PubSubSource](ctx, c, http.MethodPost, "/log-sources/pubsub", client.PubSubSourceInput{
+		IntegrationLabel: "ps-dup", SubscriptionId: "sub-1", LogTypes: []string{"GCP.AuditLog"},
+		Credentials: `{"type":"service_account","project_id":"p"}`,
+	})
+	assert.True(t, client.IsConflict(err), "one integration per subscription")
+
+	_, err = client.RestDo[client.PubSubSource](ctx, c, http.MethodPost, "/log-sources/pubsub", client.PubSubSourceInput{
+		IntegrationLabel: "ps-bad", SubscriptionId: "sub-2", LogTypes: []string{"GCP.AuditLog"}, Credentials: "not json",
+	})
+	var apiErr *client.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+}
+
+func TestServer_AlarmsFollowTheirSource(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	_, err := client.RestDo[client.LogSourceAlarm](ctx, c, http.MethodPut, "/log-source-alarms/missing/SOURCE_NO_DATA",
+		client.LogSourceAlarmInput{MinutesThreshold: 60})
+	assert.True(t, client.IsNotFound(err))
+
+	src, err := client.RestDo[client.HttpSource](ctx, c, http.MethodPost, "/log-sources/http", client.HttpSourceInput{
+		IntegrationLabel: "parent", LogTypes: []string{"AWS.CloudTrail"}, AuthMethod: "None",
+	})
+	require.NoError(t, err)
+	alarmPath := "/log-source-alarms/" + src.IntegrationId + "/SOURCE_NO_DATA"
+
+	_, err = client.RestDo[client.LogSourceAlarm](ctx, c, http.MethodPut, alarmPath, client.LogSourceAlarmInput{MinutesThreshold: 5})
+	var apiErr *client.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+
+	alarm, err := client.RestDo[client.LogSourceAlarm](ctx, c, http.MethodPut, alarmPath, client.LogSourceAlarmInput{MinutesThreshold: 60})
+	require.NoError(t, err)
+	assert.Equal(t, "SOURCE_NO_DATA", alarm.Type)
+	assert.EqualValues(t, 60, alarm.MinutesThreshold)
+
+	alarms, err := client.RestList[client.LogSourceAlarm](ctx, c, "/log-source-alarms")
+	require.NoError(t, err)
+	require.Len(t, alarms, 1)
+	assert.Equal(t, src.IntegrationId, alarms[0].SourceId)
+
+	require.NoError(t, client.RestDelete(ctx, c, "/log-sources/http/"+src.IntegrationId))
+	_, err = client.RestDo[client.LogSourceAlarm](ctx, c, http.MethodGet, alarmPath, nil)
+	assert.True(t, client.IsNotFound(err), "deleting a source deletes its alarms")
+}
+
+func TestServer_SystemManagedAlarms(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	src, err := client.RestDo[client.HttpSource](ctx, c, http.MethodPost, "/log-sources/http", client.HttpSourceInput{
+		IntegrationLabel: "parent", LogTypes: []string{"AWS.CloudTrail"}, AuthMethod: "None",
+	})
+	require.NoError(t, err)
+	alarmPath := "/log-source-alarms/" + src.IntegrationId + "/SOURCE_CLASSIFICATION_FAILURES"
+
+	alarm, err := client.RestDo[client.LogSourceAlarm](ctx, c, http.MethodGet, alarmPath, nil)
+	require.NoError(t, err, "system-managed alarms exist for every source")
+	assert.EqualValues(t, 1, alarm.CountThreshold)
+
+	for _, in := range []client.LogSourceAlarmInput{
+		{MinutesThreshold: 60},
+		{PercentageThreshold: 5, CountThreshold: 10},
+		{PercentageThreshold: 101},
+		{CountThreshold: 10, DestinationIds: []string{"missing"}},
+	} {
+		_, err = client.RestDo[client.LogSourceAlarm](ctx, c, http.MethodPut, alarmPath, in)
+		assert.True(t, client.IsBadRequest(err), "%+v", in)
+	}
+
+	_, err = client.RestDo[client.LogSourceAlarm](ctx, c, http.MethodPut, alarmPath,
+		client.LogSourceAlarmInput{PercentageThreshold: 5, Muted: true})
+	require.NoError(t, err)
+	alarm, err = client.RestDo[client.LogSourceAlarm](ctx, c, http.MethodGet, alarmPath, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, alarm.PercentageThreshold)
+	assert.True(t, alarm.Muted)
+
+	// Deleting resets the alarm instead of removing it.
+	require.NoError(t, client.RestDelete(ctx, c, alarmPath))
+	alarm, err = client.RestDo[client.LogSourceAlarm](ctx, c, http.MethodGet, alarmPath, nil)
+	require.NoError(t, err)
+	assert.False(t, alarm.Muted)
+	assert.EqualValues(t, 1, alarm.CountThreshold)
+
+	_, err = client.RestDo[client.LogSourceAlarm](ctx, c, http.MethodPut, "/log-source-alarms/"+src.IntegrationId+"/SOURCE_PERMISSIONS_CHECKS",
+		client.LogSourceAlarmInput{CountThreshold: 1})
+	assert.True(t, client.IsBadRequest(err), "permission checks have no threshold")
+}
+
+func TestServer_AlarmState(t *testing.T) {
+	srv, c := newTestServer(t)
+	ctx := context.Background()
+
+	_, err := client.RestDo[client.LogSourceAlarmStatus](ctx, c, http.MethodGet, "/log-source-alarms/missing/SOURCE_SCANNING_ERRORS", nil)
+	assert.True(t, client.IsNotFound(err))
+
+	src, err := client.RestDo[client.HttpSource](ctx, c, http.MethodPost, "/log-sources/http", client.HttpSourceInput{
+		IntegrationLabel: "parent", LogTypes: []string{"AWS.CloudTrail"}, AuthMethod: "None",
+	})
+	require.NoError(t, err)
+	scanningPath := "/log-source-alarms/" + src.IntegrationId + "/SOURCE_SCANNING_ERRORS"
+
+	status, err := client.RestDo[client.LogSourceAlarmStatus](ctx, c, http.MethodGet, scanningPath, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "OK", status.State, "system-managed alarms exist for every source")
+	assert.Zero(t, status.MinutesThreshold)
+
+	srv.SetAlarmState(src.IntegrationId, "SOURCE_SCANNING_ERRORS", "ALARM")
+	status, err = client.RestDo[client.LogSourceAlarmStatus](ctx, c, http.MethodGet, scanningPath, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ALARM", status.State)
+}
+
+func TestServer_AwsCloudAccount(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+	input := client.AwsCloudAccountInput{
+		IntegrationLabel: "acct",
+		AwsAccountId:     "123456789012",
+		AwsScanConfig:    client.AwsScanConfig{AuditRole: "arn:aws:iam::123456789012:role/Audit"},
+	}
+
+	created, err := client.RestDo[client.AwsCloudAccount](ctx, c, http.MethodPost, "/cloud-accounts/aws", input)
+	require.NoError(t, err)
+	assert.Equal(t, []string{}, created.RegionIgnoreList)
+
+	_, err = client.RestDo[client.AwsCloudAccount](ctx, c, http.MethodPost, "/cloud-accounts/aws", input)
+	assert.True(t, client.IsConflict(err))
+
+	input.AwsAccountId = ""
+	input.IntegrationLabel = "renamed"
+	updated, err := client.RestDo[client.AwsCloudAccount](ctx, c, http.MethodPut, "/cloud-accounts/aws/"+created.IntegrationId, input)
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", updated.IntegrationLabel)
+	assert.Equal(t, "123456789012", updated.AwsAccountId)
+}
+
+func TestServer_ListPaginates(t *testing.T) {
+	srv, c := newTestServer(t)
+	srv.PageSize = 2
+	ctx := context.Background()
+
+	for _, label := range []string{"one", "two", "three"} {
+		_, err := client.RestDo[client.HttpSource](ctx, c, http.MethodPost, "/log-sources/http", client.HttpSourceInput{
+			IntegrationLabel: label, LogTypes: []string{"AWS.CloudTrail"}, AuthMethod: "Bearer", AuthBearerToken: "secret",
+		})
+		require.NoError(t, err)
+	}
+
+	sources, err := client.RestList[client.HttpSource](ctx, c, "/log-sources/http")
+	require.NoError(t, err)
+	require.Len(t, sources, 3)
+	for _, src := range sources {
+		assert.Empty(t, src.AuthBearerToken)
+	}
+
+	empty, err := client.RestList[client.GcsSource](ctx, c, "/log-sources/gcs")
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestServer_LogTypes(t *testing.T) {
+	srv, c := newTestServer(t)
+	srv.PutSchema(client.Schema{Name: "Custom.Firewall"})
+	srv.PutSchema(client.Schema{Name: "Custom.Retired", IsArchived: true})
+
+	names, err := c.LogTypes(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, names, "AWS.CloudTrail")
+	assert.Contains(t, names, "Custom.Firewall")
+	assert.NotContains(t, names, "Custom.Retired")
+}
+
+func TestServer_SchemaLifecycle(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	_, err := client.RestDo[client.Schema](ctx, c, http.MethodPost, client.SchemasPath, client.SchemaInput{
+		Name: "Custom.Bad", Spec: "fields:\n  - name: msg\n",
+	})
+	assert.True(t, client.IsBadRequest(err))
+
+	input := client.SchemaInput{Name: "Custom.Good", Spec: "fields:\n  - type: string\n    name: msg\n"}
+	created, err := client.RestDo[client.Schema](ctx, c, http.MethodPost, client.SchemasPath, input)
+	require.NoError(t, err)
+	assert.Equal(t, "fields:\n    - name: msg\n      type: string\n", created.Spec)
+
+	_, err = client.RestDo[client.Schema](ctx, c, http.MethodPost, client.SchemasPath, input)
+	assert.True(t, client.IsConflict(err))
+
+	input.IsArchived = true
+	archived, err := client.RestDo[client.Schema](ctx, c, http.MethodPut, client.SchemasPath+"/Custom.Good", input)
+	require.NoError(t, err)
+	assert.True(t, archived.IsArchived)
+
+	_, err = client.RestDo[client.Schema](ctx, c, http.MethodPut, client.SchemasPath+"/AWS.CloudTrail", input)
+	assert.True(t, client.IsBadRequest(err))
+}
+
+func TestServer_RuleLifecycle(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	rule := client.Rule{
+		DetectionCore: client.DetectionCore{ID: "Test.Rule", Body: "def rule(e): return True", Severity: "LOW"},
+		LogTypes:      []string{"Custom.Missing"},
+	}
+	_, err := client.RestDo[client.Rule](ctx, c, http.MethodPost, "/rules", rule)
+	assert.True(t, client.IsBadRequest(err), "unknown log type")
+
+	rule.LogTypes = []string{"AWS.CloudTrail"}
+	rule.Tests = []client.DetectionTest{{Name: "t", Resource: "{not json"}}
+	_, err = client.RestDo[client.Rule](ctx, c, http.MethodPost, "/rules", rule)
+	assert.True(t, client.IsBadRequest(err), "invalid test resource")
+
+	rule.Tests = []client.DetectionTest{{Name: "t", ExpectedResult: true, Resource: `{"a":1}`}}
+	_, err = client.RestDo[client.Rule](ctx, c, http.MethodPost, "/rules", rule)
+	require.NoError(t, err)
+	_, err = client.RestDo[client.Rule](ctx, c, http.MethodPost, "/rules", rule)
+	assert.True(t, client.IsConflict(err))
+
+	rule.Severity = "HIGH"
+	updated, err := client.RestDo[client.Rule](ctx, c, http.MethodPut, "/rules/Test.Rule", rule)
+	require.NoError(t, err)
+	assert.Equal(t, "HIGH", updated.Severity)
+
+	require.NoError(t, client.RestDelete(ctx, c, "/rules/Test.Rule"))
+	_, err = client.RestDo[client.Rule](ctx, c, http.MethodGet, "/rules/Test.Rule", nil)
+	assert.True(t, client.IsNotFound(err))
+}
+
+func TestServer_QueryNamesUniqueAcrossKinds(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	saved, err := client.RestDo[client.SavedQuery](ctx, c, http.MethodPost, "/saved-queries", client.SavedQueryInput{
+		Name: "logins", SQL: "SELECT 1  \r\nFROM t\n",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT 1\nFROM t", saved.SQL)
+
+	scheduled := client.ScheduledQueryInput{Name: "logins", SQL: "SELECT 1", RateMinutes: 60, TimeoutMinutes: 5}
+	_, err = client.RestDo[client.ScheduledQuery](ctx, c, http.MethodPost, "/scheduled-queries", scheduled)
+	assert.True(t, client.IsConflict(err))
+
+	scheduled.Name = "hourly logins"
+	scheduled.CronExpression = "0 * * * *"
+	_, err = client.RestDo[client.ScheduledQuery](ctx, c, http.MethodPost, "/scheduled-queries", scheduled)
+	assert.True(t, client.IsBadRequest(err), "cron and rate both set")
+}
+
+func TestServer_DestinationSecretsAndOverrides(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	slack := client.SlackDestination{
+		DestinationCore: client.DestinationCore{DisplayName: "alerts", Severities: []string{"HIGH"}, AlertTypes: []string{"RULE"}},
+		WebhookURL:      "https://hooks.slack.com/services/T/B/X",
+	}
+	created, err := client.RestDo[client.SlackDestination](ctx, c, http.MethodPost, "/destinations/slack", slack)
+	require.NoError(t, err)
+	assert.Empty(t, created.WebhookURL, "webhook URL is write-only")
+
+	rule := client.Rule{
+		DetectionCore: client.DetectionCore{ID: "Test.Routed", Body: "def rule(e): return True", Severity: "HIGH",
+			OutputIDs: []string{"missing"}},
+		LogTypes: []string{"AWS.CloudTrail"},
+	}
+	_, err = client.RestDo[client.Rule](ctx, c, http.MethodPost, "/rules", rule)
+	assert.True(t, client.IsBadRequest(err), "unknown destination")
+
+	rule.OutputIDs = []string{created.ID}
+	_, err = client.RestDo[client.Rule](ctx, c, http.MethodPost, "/rules", rule)
+	require.NoError(t, err)
+}
+
+func TestServer_LookupTableData(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	input := client.LookupTableInput{Name: "assets", LogType: "AWS.CloudTrail", PrimaryKey: "ip"}
+	table, err := client.RestDo[client.LookupTable](ctx, c, http.MethodPost, "/lookup-tables", input)
+	require.NoError(t, err)
+	assert.Empty(t, table.DataHash)
+
+	_, err = client.RestDo[client.LookupTable](ctx, c, http.MethodPost, "/lookup-tables", input)
+	assert.True(t, client.IsConflict(err))
+
+	table, err = client.RestDo[client.LookupTable](ctx, c, http.MethodPut, "/lookup-tables/"+table.ID+"/data",
+		client.LookupTableDataInput{Data: "ip,owner\n10.0.0.1,alice\n"})
+	require.NoError(t, err)
+	assert.Equal(t, "8545ed801e9e69100db5a010a7261a211b96f85496136a0a2a94b20c780120cb", table.DataHash)
+
+	input.Refresh = &client.LookupTableRefresh{
+		S3ObjectPath: "s3://bucket/assets.csv", RoleARN: "arn:aws:iam::123456789012:role/reader", PeriodMinutes: 60,
+	}
+	table, err = client.RestDo[client.LookupTable](ctx, c, http.MethodPut, "/lookup-tables/"+table.ID, input)
+	require.NoError(t, err)
+	assert.Empty(t, table.DataHash, "switching to S3 discards uploaded data")
+
+	_, err = client.RestDo[client.LookupTable](ctx, c, http.MethodPut, "/lookup-tables/"+table.ID+"/data",
+		client.LookupTableDataInput{Data: "ip,owner\n"})
+	assert.True(t, client.IsBadRequest(err), "S3-refreshed tables don't accept uploads")
+}
+
+func TestServer_DataModelPerLogType(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	model := client.DataModel{
+		ID: "Test.CloudTrail", Enabled: true, LogTypes: []string{"AWS.CloudTrail"},
+		Mappings: []client.DataModelMapping{{Name: "actor_user", Method: "get_user"}},
+	}
+	_, err := client.RestDo[client.DataModel](ctx, c, http.MethodPost, "/data-models", model)
+	assert.True(t, client.IsBadRequest(err), "method not defined in body")
+
+	model.Body = "def get_user(event):\n    return event.get('userIdentity')\n"
+	_, err = client.RestDo[client.DataModel](ctx, c, http.MethodPost, "/data-models", model)
+	require.NoError(t, err)
+
+	model.ID = "Test.CloudTrail.Other"
+	_, err = client.RestDo[client.DataModel](ctx, c, http.MethodPost, "/data-models", model)
+	assert.True(t, client.IsConflict(err), "second enabled data model for the log type")
+
+	model.Enabled = false
+	_, err = client.RestDo[client.DataModel](ctx, c, http.MethodPost, "/data-models", model)
+	require.NoError(t, err)
+}
+
+func TestServer_AccessManagement(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	role, err := client.RestDo[client.Role](ctx, c, http.MethodPost, "/roles", client.RoleInput{
+		Name: "Analyst", Permissions: []string{"AlertRead"}, LogTypeAccessKind: "ALLOW_ALL", LogTypeAccess: []string{},
+	})
+	require.NoError(t, err)
+	user, err := client.RestDo[client.User](ctx, c, http.MethodPost, "/users", client.UserInput{
+		Email: "jane@example.com", GivenName: "Jane", FamilyName: "Doe", RoleID: role.ID,
+	})
+	require.NoError(t, err)
+
+	err = client.RestDelete(ctx, c, "/roles/"+role.ID)
+	assert.True(t, client.IsConflict(err), "role is assigned to a user")
+	require.NoError(t, client.RestDelete(ctx, c, "/users/"+user.ID))
+	require.NoError(t, client.RestDelete(ctx, c, "/roles/"+role.ID))
+
+	input := client.APITokenInput{Name: "ci", Permissions: []string{"RuleRead"}, AllowedCIDRBlocks: []string{"10.0.0.0/8"}}
+	token, err := client.RestDo[client.APIToken](ctx, c, http.MethodPost, "/api-tokens", input)
+	require.NoError(t, err)
+	assert.NotEmpty(t, token.Value, "value is returned on create")
+
+	token, err = client.RestDo[client.APIToken](ctx, c, http.MethodGet, "/api-tokens/"+token.ID, nil)
+	require.NoError(t, err)
+	assert.Empty(t, token.Value, "value is never returned again")
+
+	input.ExpiresAt = "2099-01-01T00:00:00Z"
+	_, err = client.RestDo[client.APIToken](ctx, c, http.MethodPut, "/api-tokens/"+token.ID, input)
+	assert.True(t, client.IsBadRequest(err), "expiry can't be changed")
+}
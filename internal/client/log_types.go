@@ -0,0 +1,74 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// logTypeCache holds the instance's log type catalog. It is fetched on first use and
+// kept for the life of the client, i.e. one Terraform run.
+type logTypeCache struct {
+	mu      sync.Mutex
+	loaded  bool
+	names   []string
+	err     error
+	planned []string
+}
+
+// LogTypes returns the sorted names of every non-archived schema (managed and custom)
+// in the instance, plus any added with PlanLogType. The first call lists /schemas;
+// later calls, including concurrent ones, share that result, errors included.
+func (c *RESTClient) LogTypes(ctx context.Context) ([]string, error) {
+	c.logTypes.mu.Lock()
+	defer c.logTypes.mu.Unlock()
+	if !c.logTypes.loaded {
+		c.logTypes.names, c.logTypes.err = listLogTypes(ctx, c)
+		c.logTypes.loaded = true
+	}
+	if c.logTypes.err != nil {
+		return nil, c.logTypes.err
+	}
+	names := append(slices.Clone(c.logTypes.names), c.logTypes.planned...)
+	slices.Sort(names)
+	return slices.Compact(names), nil
+}
+
+// PlanLogType records a log type that will exist once the current run is applied,
+// such as a custom schema planned for creation, so LogTypes treats it as available.
+func (c *RESTClient) PlanLogType(name string) {
+	c.logTypes.mu.Lock()
+	defer c.logTypes.mu.Unlock()
+	c.logTypes.planned = append(c.logTypes.planned, name)
+}
+
+func listLogTypes(ctx context.Context, c *RESTClient) ([]string, error) {
+	schemas, err := RestList[Schema](ctx, c, SchemasPath)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(schemas))
+	for _, s := range schemas {
+		if !s.IsArchived {
+			names = append(names, s.Name)
+		}
+	}
+	slices.Sort(names)
+	return slices.Compact(names), nil
+}
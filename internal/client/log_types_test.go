@@ -0,0 +1,84 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogTypes_FetchedOnceAndFiltered(t *testing.T) {
+	var calls atomic.Int32
+	doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+		calls.Add(1)
+		assert.Equal(t, "https://api.example.com/schemas", req.URL.String())
+		return jsonResponse(http.StatusOK, ListResponse[Schema]{Results: []Schema{
+			{Name: "Custom.Firewall"},
+			{Name: "AWS.CloudTrail", IsManaged: true},
+			{Name: "Custom.Old", IsArchived: true},
+		}}), nil
+	}}
+	c := testClient(doer)
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			names, err := c.LogTypes(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"AWS.CloudTrail", "Custom.Firewall"}, names)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestLogTypes_ErrorIsCached(t *testing.T) {
+	var calls atomic.Int32
+	doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+		calls.Add(1)
+		return jsonResponse(http.StatusForbidden, httpErrorResponse{Message: "missing permission"}), nil
+	}}
+	c := testClient(doer)
+
+	for range 2 {
+		_, err := c.LogTypes(context.Background())
+		require.Error(t, err)
+		assert.True(t, IsForbidden(err))
+	}
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestLogTypes_IncludesPlanned(t *testing.T) {
+	doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, ListResponse[Schema]{Results: []Schema{{Name: "AWS.CloudTrail", IsManaged: true}}}), nil
+	}}
+	c := testClient(doer)
+	c.PlanLogType("Custom.Firewall")
+	c.PlanLogType("AWS.CloudTrail")
+
+	names, err := c.LogTypes(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"AWS.CloudTrail", "Custom.Firewall"}, names)
+}
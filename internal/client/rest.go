@@ -24,20 +24,44 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const graphQLPath = "/public/graphql"
 
+// maxResponseBytes bounds how much of a success response RestDo reads (the error
+// path is limited separately, to 1 MB). A var so tests can lower it.
+var maxResponseBytes int64 = 32 << 20
+
+// Option customizes a RESTClient built by NewRESTClient.
+type Option func(*clientOptions)
+
+type clientOptions struct {
+	limits Limits
+}
+
+// WithLimits throttles every request made through the client. See Limits.
+func WithLimits(limits Limits) Option {
+	return func(o *clientOptions) { o.limits = limits }
+}
+
 // NewRESTClient creates a configured REST client for the Panther API.
-func NewRESTClient(url, token, userAgent string) *RESTClient {
+func NewRESTClient(url, token, userAgent string, opts ...Option) *RESTClient {
+	var o clientOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
 	// Strip the legacy /public/graphql suffix — older provider configs included it.
 	pantherURL := strings.TrimSuffix(url, graphQLPath)
-	httpClient := newHTTPClient(token, userAgent)
+	httpClient := newHTTPClient(token, userAgent, o.limits)
 
 	return &RESTClient{
 		Doer:    httpClient,
 		BaseURL: pantherURL,
+		Retry:   DefaultRetryPolicy(),
 	}
 }
 
@@ -50,6 +74,12 @@ type APIError struct {
 	Message    string
 	Method     string
 	URL        string
+	// MissingPermissions names the permissions the token lacks, when a 403 response
+	// says which.
+	MissingPermissions []string
+	// RejectedCredentials names the request fields holding third-party credentials
+	// (e.g. a SaaS API token) that the third party refused, when a 400 response says which.
+	RejectedCredentials []string
 }
 
 func (e *APIError) Error() string {
@@ -61,6 +91,9 @@ func hasStatusCode(err error, code int) bool {
 	return errors.As(err, &apiErr) && apiErr.StatusCode == code
 }
 
+// IsBadRequest reports whether err is an HTTP 400 (the API rejected the request body).
+func IsBadRequest(err error) bool { return hasStatusCode(err, http.StatusBadRequest) }
+
 // IsNotFound reports whether err is an HTTP 404.
 func IsNotFound(err error) bool { return hasStatusCode(err, http.StatusNotFound) }
 
@@ -73,9 +106,32 @@ func IsUnauthorized(err error) bool { return hasStatusCode(err, http.StatusUnaut
 // IsForbidden reports whether err is an HTTP 403 (insufficient permissions).
 func IsForbidden(err error) bool { return hasStatusCode(err, http.StatusForbidden) }
 
+// MissingPermissions returns the permissions a 403 err reports the token lacks, or nil
+// if err isn't a 403 or the API didn't say.
+func MissingPermissions(err error) []string {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		return nil
+	}
+	return apiErr.MissingPermissions
+}
+
+// RejectedCredentials returns the credential fields a 400 err reports the third party
+// refused, or nil if err isn't a 400 or the API didn't say.
+func RejectedCredentials(err error) []string {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		return nil
+	}
+	return apiErr.RejectedCredentials
+}
+
 type RESTClient struct {
 	Doer    Doer
 	BaseURL string
+	Retry   RetryPolicy
+
+	logTypes logTypeCache
 }
 
 func isHTTPSuccess(statusCode int) bool {
@@ -83,41 +139,73 @@ func isHTTPSuccess(statusCode int) bool {
 }
 
 // restExec builds the URL, creates the request, executes it, and checks for 2xx.
+// Throttled and transiently failing attempts are retried according to c.Retry;
+// body is replayed from the byte slice on every attempt.
 // On success it returns the open *http.Response (caller must close Body).
-// On failure it returns an *APIError.
-func restExec(ctx context.Context, c *RESTClient, method, path string, body io.Reader) (*http.Response, error) {
+// On failure it returns an *APIError for the last attempt.
+func restExec(ctx context.Context, c *RESTClient, method, path string, body []byte) (*http.Response, error) {
 	url := c.BaseURL + path
-	req, err := http.NewRequestWithContext(ctx, method, url, body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create http request: %w", err)
-	}
-	resp, err := c.Doer.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make request: %w", err)
-	}
-	if !isHTTPSuccess(resp.StatusCode) {
-		defer resp.Body.Close()
-		return nil, &APIError{
-			StatusCode: resp.StatusCode,
-			Message:    getErrorResponseMsg(resp),
-			Method:     method,
-			URL:        url,
+	for attempt := 0; ; attempt++ {
+		var reqBody io.Reader
+		if body != nil {
+			reqBody = bytes.NewReader(body)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create http request: %w", err)
+		}
+		resp, err := c.Doer.Do(req)
+		if err == nil && isHTTPSuccess(resp.StatusCode) {
+			return resp, nil
+		}
+		if attempt >= c.Retry.MaxRetries || !shouldRetry(method, resp, err) {
+			if err != nil {
+				return nil, fmt.Errorf("failed to make request: %w", err)
+			}
+			defer resp.Body.Close()
+			errResponse := getErrorResponse(resp)
+			return nil, &APIError{
+				StatusCode:          resp.StatusCode,
+				Message:             errResponse.Message,
+				Method:              method,
+				URL:                 url,
+				MissingPermissions:  errResponse.MissingPermissions,
+				RejectedCredentials: errResponse.RejectedCredentials,
+			}
+		}
+
+		wait := c.Retry.backoff(attempt, resp)
+		reason := "transport error"
+		if err == nil {
+			reason = resp.Status
+			// Drain so the underlying connection can be reused for the retry.
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+			resp.Body.Close()
+		}
+		tflog.Debug(ctx, "Retrying Panther API request", map[string]any{
+			"method":  method,
+			"url":     url,
+			"attempt": attempt + 1,
+			"reason":  reason,
+			"wait":    wait.String(),
+		})
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, fmt.Errorf("gave up retrying %s %s after %s: %w", method, url, reason, err)
 		}
 	}
-	return resp, nil
 }
 
 // RestDo sends an HTTP request to c.BaseURL+path, marshals body as JSON,
 // checks for 2xx success, and unmarshals the response into Resp.
 func RestDo[Resp any](ctx context.Context, c *RESTClient, method, path string, body any) (Resp, error) {
 	var zero Resp
-	var reqBody io.Reader
+	var reqBody []byte
 	if body != nil {
 		jsonData, err := json.Marshal(body)
 		if err != nil {
 			return zero, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewReader(jsonData)
+		reqBody = jsonData
 	}
 	resp, err := restExec(ctx, c, method, path, reqBody)
 	if err != nil {
@@ -125,12 +213,16 @@ func RestDo[Resp any](ctx context.Context, c *RESTClient, method, path string, b
 	}
 	defer resp.Body.Close()
 
-	// Single-resource responses are typically < 10 KB. If list endpoints are added,
-	// consider using io.LimitReader here (the error path already limits to 1 MB).
-	respBody, err := io.ReadAll(resp.Body)
+	// Single-resource responses are typically < 10 KB, but list pages grow with the
+	// number of sources in the instance. Read one byte past the cap so an oversized
+	// body is reported instead of silently truncated into invalid JSON.
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes+1))
 	if err != nil {
 		return zero, fmt.Errorf("failed to read response body (status %d): %w", resp.StatusCode, err)
 	}
+	if int64(len(respBody)) > maxResponseBytes {
+		return zero, fmt.Errorf("response body from %s %s exceeds %d bytes", method, path, maxResponseBytes)
+	}
 	var response Resp
 	if len(respBody) == 0 {
 		return response, nil
@@ -141,6 +233,44 @@ func RestDo[Resp any](ctx context.Context, c *RESTClient, method, path string, b
 	return response, nil
 }
 
+// ListResponse is the envelope returned by the API's paginated list endpoints.
+// Next is an opaque cursor; it is empty on the last page.
+type ListResponse[T any] struct {
+	Results []T    `json:"results"`
+	Next    string `json:"next,omitempty"`
+}
+
+// RestList GETs c.BaseURL+path and follows the "next" cursor until every page
+// has been read, returning the concatenated results. path may carry its own query
+// (e.g. filters); the cursor is added to it.
+func RestList[T any](ctx context.Context, c *RESTClient, path string) ([]T, error) {
+	pageURL, err := url.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("list %s: %w", path, err)
+	}
+	results := []T{}
+	cursor := ""
+	for {
+		if cursor != "" {
+			query := pageURL.Query()
+			query.Set("cursor", cursor)
+			pageURL.RawQuery = query.Encode()
+		}
+		page, err := RestDo[ListResponse[T]](ctx, c, http.MethodGet, pageURL.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, page.Results...)
+		if page.Next == "" {
+			return results, nil
+		}
+		if page.Next == cursor {
+			return nil, fmt.Errorf("list %s: API returned the same cursor twice (%q)", path, cursor)
+		}
+		cursor = page.Next
+	}
+}
+
 // RestDelete sends a DELETE request to c.BaseURL+path. No response body is read.
 func RestDelete(ctx context.Context, c *RESTClient, path string) error {
 	resp, err := restExec(ctx, c, http.MethodDelete, path, nil)
@@ -152,28 +282,31 @@ func RestDelete(ctx context.Context, c *RESTClient, path string) error {
 }
 
 type httpErrorResponse struct {
-	Message string `json:"message"`
+	Message             string   `json:"message"`
+	MissingPermissions  []string `json:"missingPermissions,omitempty"`
+	RejectedCredentials []string `json:"rejectedCredentials,omitempty"`
 }
 
-func getErrorResponseMsg(resp *http.Response) string {
+// getErrorResponse parses the API's error envelope. A body that isn't one (e.g. HTML
+// from a load balancer) becomes the message, truncated.
+func getErrorResponse(resp *http.Response) httpErrorResponse {
 	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20)) // 1 MB max
 	if err != nil {
-		return fmt.Sprintf("failed to read error response body: %v", err)
+		return httpErrorResponse{Message: fmt.Sprintf("failed to read error response body: %v", err)}
 	}
 
 	if len(body) == 0 {
-		return "(empty response body)"
+		return httpErrorResponse{Message: "(empty response body)"}
 	}
 
 	var errResponse httpErrorResponse
 	if err = json.Unmarshal(body, &errResponse); err != nil || errResponse.Message == "" {
-		// Non-JSON response (e.g. HTML from a load balancer) — return raw body truncated
 		const maxDisplay = 512
 		if len(body) > maxDisplay {
-			return string(body[:maxDisplay]) + "... (truncated)"
+			return httpErrorResponse{Message: string(body[:maxDisplay]) + "... (truncated)"}
 		}
-		return string(body)
+		return httpErrorResponse{Message: string(body)}
 	}
 
-	return errResponse.Message
+	return errResponse
 }
@@ -20,10 +20,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -159,6 +162,25 @@ func TestRestDo_UnmarshalError(t *testing.T) {
 	assert.Contains(t, err.Error(), "status 200")
 }
 
+func TestRestDo_ResponseTooLarge(t *testing.T) {
+	defer func(prev int64) { maxResponseBytes = prev }(maxResponseBytes)
+	maxResponseBytes = 32
+
+	doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, testResp{ID: "id-1", Name: "well past the thirty-two byte cap"}), nil
+	}}
+	_, err := RestDo[testResp](context.Background(), testClient(doer), http.MethodGet, "/things", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeds 32 bytes")
+
+	doer.handler = func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, testResp{ID: "id-1"}), nil
+	}
+	resp, err := RestDo[testResp](context.Background(), testClient(doer), http.MethodGet, "/things", nil)
+	require.NoError(t, err, "a body under the cap is read in full")
+	assert.Equal(t, "id-1", resp.ID)
+}
+
 func TestRestDo_TransportError(t *testing.T) {
 	doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
 		return nil, fmt.Errorf("connection refused")
@@ -244,6 +266,82 @@ func TestRestDelete_Any2xx(t *testing.T) {
 	}
 }
 
+func TestRestList_FollowsCursor(t *testing.T) {
+	var paths []string
+	doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+		paths = append(paths, req.URL.RequestURI())
+		switch req.URL.Query().Get("cursor") {
+		case "":
+			return jsonResponse(http.StatusOK, ListResponse[testResp]{Results: []testResp{{ID: "a"}, {ID: "b"}}, Next: "page/2"}), nil
+		case "page/2":
+			return jsonResponse(http.StatusOK, ListResponse[testResp]{Results: []testResp{{ID: "c"}}}), nil
+		}
+		return jsonResponse(http.StatusBadRequest, httpErrorResponse{Message: "bad cursor"}), nil
+	}}
+
+	results, err := RestList[testResp](context.Background(), testClient(doer), "/things")
+
+	require.NoError(t, err)
+	assert.Equal(t, []testResp{{ID: "a"}, {ID: "b"}, {ID: "c"}}, results)
+	assert.Equal(t, []string{"/things", "/things?cursor=page%2F2"}, paths)
+}
+
+func TestRestList_KeepsQuery(t *testing.T) {
+	var paths []string
+	doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+		paths = append(paths, req.URL.RequestURI())
+		next := ""
+		if req.URL.Query().Get("cursor") == "" {
+			next = "2"
+		}
+		return jsonResponse(http.StatusOK, ListResponse[testResp]{Results: []testResp{{ID: "a"}}, Next: next}), nil
+	}}
+
+	_, err := RestList[testResp](context.Background(), testClient(doer), "/things?type=okta")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/things?type=okta", "/things?cursor=2&type=okta"}, paths)
+}
+
+func TestRestList_Empty(t *testing.T) {
+	doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, map[string]any{"results": nil}), nil
+	}}
+
+	results, err := RestList[testResp](context.Background(), testClient(doer), "/things")
+
+	require.NoError(t, err)
+	assert.NotNil(t, results)
+	assert.Empty(t, results)
+}
+
+func TestRestList_RepeatedCursor(t *testing.T) {
+	doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusOK, ListResponse[testResp]{Results: []testResp{{ID: "a"}}, Next: "same"}), nil
+	}}
+
+	_, err := RestList[testResp](context.Background(), testClient(doer), "/things")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "same cursor twice")
+}
+
+func TestRestList_PageError(t *testing.T) {
+	calls := 0
+	doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+		calls++
+		if calls == 1 {
+			return jsonResponse(http.StatusOK, ListResponse[testResp]{Results: []testResp{{ID: "a"}}, Next: "2"}), nil
+		}
+		return jsonResponse(http.StatusForbidden, httpErrorResponse{Message: "denied"}), nil
+	}}
+
+	results, err := RestList[testResp](context.Background(), testClient(doer), "/things")
+
+	assert.Nil(t, results)
+	assert.True(t, IsForbidden(err))
+}
+
 func TestAPIError_Error(t *testing.T) {
 	err := &APIError{
 		StatusCode: 404,
@@ -279,7 +377,7 @@ func TestAPIErrorPredicates(t *testing.T) {
 	}
 }
 
-func TestGetErrorResponseMsg(t *testing.T) {
+func TestGetErrorResponse(t *testing.T) {
 	tests := []struct {
 		name         string
 		body         string
@@ -297,7 +395,7 @@ func TestGetErrorResponseMsg(t *testing.T) {
 			resp := &http.Response{
 				Body: io.NopCloser(bytes.NewReader([]byte(tt.body))),
 			}
-			msg := getErrorResponseMsg(resp)
+			msg := getErrorResponse(resp).Message
 			if tt.wantExact != "" {
 				assert.Equal(t, tt.wantExact, msg)
 			} else {
@@ -307,16 +405,46 @@ func TestGetErrorResponseMsg(t *testing.T) {
 	}
 }
 
-func TestGetErrorResponseMsg_LargeBody(t *testing.T) {
+func TestGetErrorResponse_LargeBody(t *testing.T) {
 	largeBody := bytes.Repeat([]byte("x"), 2<<20) // 2 MB
 	resp := &http.Response{
 		Body: io.NopCloser(bytes.NewReader(largeBody)),
 	}
-	msg := getErrorResponseMsg(resp)
+	msg := getErrorResponse(resp).Message
 	assert.LessOrEqual(t, len(msg), 600)
 	assert.Contains(t, msg, "... (truncated)")
 }
 
+func TestRestDo_ForbiddenMissingPermissions(t *testing.T) {
+	doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusForbidden, httpErrorResponse{
+			Message:            "token lacks required permissions",
+			MissingPermissions: []string{"RuleModify"},
+		}), nil
+	}}
+
+	_, err := RestDo[testResp](context.Background(), testClient(doer), http.MethodPost, "/rules", testResp{})
+
+	assert.True(t, IsForbidden(err))
+	assert.Equal(t, []string{"RuleModify"}, MissingPermissions(err))
+	assert.Nil(t, MissingPermissions(&APIError{StatusCode: http.StatusBadRequest, MissingPermissions: []string{"RuleModify"}}))
+}
+
+func TestRestDo_BadRequestRejectedCredentials(t *testing.T) {
+	doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusBadRequest, httpErrorResponse{
+			Message:             "okta returned 401: Invalid token provided",
+			RejectedCredentials: []string{"apiToken"},
+		}), nil
+	}}
+
+	_, err := RestDo[testResp](context.Background(), testClient(doer), http.MethodPost, "/log-sources/okta", testResp{})
+
+	assert.True(t, IsBadRequest(err))
+	assert.Equal(t, []string{"apiToken"}, RejectedCredentials(err))
+	assert.Nil(t, RejectedCredentials(&APIError{StatusCode: http.StatusForbidden, RejectedCredentials: []string{"apiToken"}}))
+}
+
 // Tests verify that NewRESTClient correctly strips /public/graphql from the URL
 // (backwards compatibility for users who configured the old URL format)
 func TestNewRESTClient_StripsGraphQLSuffix(t *testing.T) {
@@ -337,3 +465,230 @@ func TestNewRESTClient_StripsGraphQLSuffix(t *testing.T) {
 		})
 	}
 }
+
+// fastRetry keeps retry tests quick while still exercising the backoff path.
+var fastRetry = RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+func TestRestDo_RetriesTransientStatuses(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		t.Run(fmt.Sprintf("%d", status), func(t *testing.T) {
+			attempts := 0
+			doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+				attempts++
+				if attempts < 3 {
+					return jsonResponse(status, httpErrorResponse{Message: "slow down"}), nil
+				}
+				return jsonResponse(http.StatusOK, testResp{ID: "id-1"}), nil
+			}}
+			c := testClient(doer)
+			c.Retry = fastRetry
+
+			resp, err := RestDo[testResp](context.Background(), c, http.MethodGet, "/things/id-1", nil)
+			require.NoError(t, err)
+			assert.Equal(t, "id-1", resp.ID)
+			assert.Equal(t, 3, attempts)
+		})
+	}
+}
+
+func TestRestDo_RetryReplaysBody(t *testing.T) {
+	var bodies []string
+	doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+		data, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		bodies = append(bodies, string(data))
+		if len(bodies) == 1 {
+			return jsonResponse(http.StatusServiceUnavailable, nil), nil
+		}
+		return jsonResponse(http.StatusOK, testResp{ID: "id-1"}), nil
+	}}
+	c := testClient(doer)
+	c.Retry = fastRetry
+
+	_, err := RestDo[testResp](context.Background(), c, http.MethodPut, "/things/id-1", testInput{Name: "x"})
+	require.NoError(t, err)
+	require.Len(t, bodies, 2)
+	assert.Equal(t, bodies[0], bodies[1])
+	assert.JSONEq(t, `{"name":"x"}`, bodies[1])
+}
+
+func TestRestDo_RetryExhausted(t *testing.T) {
+	attempts := 0
+	doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return jsonResponse(http.StatusTooManyRequests, httpErrorResponse{Message: "rate exceeded"}), nil
+	}}
+	c := testClient(doer)
+	c.Retry = fastRetry
+
+	_, err := RestDo[testResp](context.Background(), c, http.MethodGet, "/things/id-1", nil)
+	require.Error(t, err)
+	assert.Equal(t, fastRetry.MaxRetries+1, attempts)
+	assert.True(t, hasStatusCode(err, http.StatusTooManyRequests))
+	assert.Contains(t, err.Error(), "rate exceeded")
+}
+
+func TestRestDo_NoRetryOnClientErrors(t *testing.T) {
+	for _, status := range []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusNotFound, http.StatusConflict, http.StatusInternalServerError} {
+		t.Run(fmt.Sprintf("%d", status), func(t *testing.T) {
+			attempts := 0
+			doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+				attempts++
+				return jsonResponse(status, httpErrorResponse{Message: "nope"}), nil
+			}}
+			c := testClient(doer)
+			c.Retry = fastRetry
+
+			_, err := RestDo[testResp](context.Background(), c, http.MethodGet, "/things/id-1", nil)
+			require.Error(t, err)
+			assert.Equal(t, 1, attempts)
+		})
+	}
+}
+
+func TestRestDo_PostRetryRules(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		wantAttempts int
+	}{
+		{"TooManyRequestsRetried", http.StatusTooManyRequests, 2},
+		{"ServiceUnavailableRetried", http.StatusServiceUnavailable, 2},
+		// A 502/504 may mean the backend created the resource before the gateway
+		// gave up; retrying a POST could create a duplicate.
+		{"BadGatewayNotRetried", http.StatusBadGateway, 1},
+		{"GatewayTimeoutNotRetried", http.StatusGatewayTimeout, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+				attempts++
+				if attempts == 1 {
+					return jsonResponse(tt.status, nil), nil
+				}
+				return jsonResponse(http.StatusCreated, testResp{ID: "id-1"}), nil
+			}}
+			c := testClient(doer)
+			c.Retry = fastRetry
+
+			_, _ = RestDo[testResp](context.Background(), c, http.MethodPost, "/things", testInput{Name: "x"})
+			assert.Equal(t, tt.wantAttempts, attempts)
+		})
+	}
+}
+
+func TestRestDo_PostRetriesDialErrorOnly(t *testing.T) {
+	attempts := 0
+	doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return nil, io.ErrUnexpectedEOF
+	}}
+	c := testClient(doer)
+	c.Retry = fastRetry
+	_, err := RestDo[testResp](context.Background(), c, http.MethodPost, "/things", testInput{Name: "x"})
+	require.Error(t, err)
+	assert.Equal(t, 1, attempts, "POST must not be retried after the connection was established")
+
+	attempts = 0
+	doer.handler = func(req *http.Request) (*http.Response, error) {
+		attempts++
+		if attempts == 1 {
+			return nil, &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+		}
+		return jsonResponse(http.StatusCreated, testResp{ID: "id-1"}), nil
+	}
+	_, err = RestDo[testResp](context.Background(), c, http.MethodPost, "/things", testInput{Name: "x"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRestDo_RetryHonorsContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+		attempts++
+		cancel()
+		return jsonResponse(http.StatusServiceUnavailable, nil), nil
+	}}
+	c := testClient(doer)
+	c.Retry = RetryPolicy{MaxRetries: 5, MinBackoff: time.Hour, MaxBackoff: time.Hour}
+
+	_, err := RestDo[testResp](ctx, c, http.MethodGet, "/things/id-1", nil)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRestDelete_Retries(t *testing.T) {
+	attempts := 0
+	doer := &mockDoer{handler: func(req *http.Request) (*http.Response, error) {
+		attempts++
+		if attempts == 1 {
+			return jsonResponse(http.StatusBadGateway, nil), nil
+		}
+		return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody}, nil
+	}}
+	c := testClient(doer)
+	c.Retry = fastRetry
+
+	require.NoError(t, RestDelete(context.Background(), c, "/things/id-1"))
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := RetryPolicy{MaxRetries: 10, MinBackoff: time.Second, MaxBackoff: 8 * time.Second}
+	for attempt := 0; attempt < 10; attempt++ {
+		step := time.Second << attempt
+		if step > p.MaxBackoff {
+			step = p.MaxBackoff
+		}
+		wait := p.backoff(attempt, nil)
+		assert.GreaterOrEqual(t, wait, step/2, "attempt %d", attempt)
+		assert.LessOrEqual(t, wait, step, "attempt %d", attempt)
+	}
+}
+
+func TestRetryPolicy_BackoffHonorsRetryAfter(t *testing.T) {
+	p := RetryPolicy{MaxRetries: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Minute}
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	assert.Equal(t, 7*time.Second, p.backoff(0, resp))
+
+	// Retry-After never pushes a single wait past MaxBackoff.
+	resp.Header.Set("Retry-After", "3600")
+	assert.Equal(t, time.Minute, p.backoff(0, resp))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	future := time.Now().Add(90 * time.Second).UTC().Format(http.TimeFormat)
+	tests := []struct {
+		name    string
+		header  string
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{"Absent", "", 0, 0},
+		{"Seconds", "12", 12 * time.Second, 12 * time.Second},
+		{"HTTPDate", future, 80 * time.Second, 90 * time.Second},
+		{"PastDate", "Wed, 21 Oct 2015 07:28:00 GMT", 0, 0},
+		{"Garbage", "soon", 0, 0},
+		{"Negative", "-5", 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tt.header != "" {
+				resp.Header.Set("Retry-After", tt.header)
+			}
+			got := parseRetryAfter(resp)
+			assert.GreaterOrEqual(t, got, tt.wantMin)
+			assert.LessOrEqual(t, got, tt.wantMax)
+		})
+	}
+	assert.Zero(t, parseRetryAfter(nil))
+}
+
+func TestNewRESTClient_DefaultRetryPolicy(t *testing.T) {
+	c := NewRESTClient("panther-url", "token", "ua")
+	assert.Equal(t, DefaultRetryPolicy(), c.Retry)
+}
@@ -17,9 +17,15 @@ limitations under the License.
 package client
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"math"
 	"net/http"
+	"sync"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
 type authTransport struct {
@@ -48,13 +54,112 @@ func BuildUserAgent(providerVersion, terraformVersion string) string {
 	return fmt.Sprintf("Terraform/%s terraform-provider-panther/%s", terraformVersion, providerVersion)
 }
 
-func newHTTPClient(token, userAgent string) *http.Client {
+// Limits caps the request rate and concurrency of a RESTClient. Every resource
+// shares the provider's single client, so the budget applies across the whole
+// Terraform run. Zero values mean unlimited.
+type Limits struct {
+	RequestsPerSecond     float64
+	MaxConcurrentRequests int
+}
+
+// limitTransport throttles requests with a token bucket and bounds how many are
+// in flight. A concurrency slot is held until the response body is closed, so a
+// slow body read counts against the cap just like a slow response.
+type limitTransport struct {
+	limiter *rate.Limiter // nil means no rate limit
+	slots   chan struct{} // nil means no concurrency cap
+	next    http.RoundTripper
+}
+
+func newLimitTransport(limits Limits, next http.RoundTripper) http.RoundTripper {
+	if limits.RequestsPerSecond <= 0 && limits.MaxConcurrentRequests <= 0 {
+		return next
+	}
+	t := &limitTransport{next: next}
+	if limits.RequestsPerSecond > 0 {
+		burst := int(math.Ceil(limits.RequestsPerSecond))
+		t.limiter = rate.NewLimiter(rate.Limit(limits.RequestsPerSecond), burst)
+	}
+	if limits.MaxConcurrentRequests > 0 {
+		t.slots = make(chan struct{}, limits.MaxConcurrentRequests)
+	}
+	return t
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if t.slots != nil {
+			<-t.slots
+		}
+	}
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// requestTimeout bounds one attempt, from sending the request to closing the response body.
+const requestTimeout = 30 * time.Second
+
+// timeoutTransport gives each request its own deadline, covering the round trip and the
+// body read like http.Client.Timeout does. It sits below limitTransport, so time spent
+// waiting for a concurrency slot or a rate token doesn't count against it.
+type timeoutTransport struct {
+	timeout time.Duration
+	next    http.RoundTripper
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: cancel}
+	return resp, nil
+}
+
+// releaseOnClose runs release (freeing a concurrency slot or cancelling a request's
+// deadline) exactly once when the body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+func newHTTPClient(token, userAgent string, limits Limits) *http.Client {
 	return &http.Client{
-		Timeout: 30 * time.Second,
-		Transport: &authTransport{
-			token:     token,
-			userAgent: userAgent,
-			next:      http.DefaultTransport,
-		},
+		Transport: newLimitTransport(limits, &timeoutTransport{
+			timeout: requestTimeout,
+			next: &authTransport{
+				token:     token,
+				userAgent: userAgent,
+				next:      http.DefaultTransport,
+			},
+		}),
 	}
 }
@@ -17,10 +17,12 @@ limitations under the License.
 package client
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -101,9 +103,42 @@ func TestAuthTransport_PassesThroughErrors(t *testing.T) {
 	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
 }
 
-func TestNewHTTPClient_Timeout(t *testing.T) {
-	client := newHTTPClient("token", "ua")
-	assert.Equal(t, 30*time.Second, client.Timeout)
+func TestTimeoutTransport_DeadlinePerRequest(t *testing.T) {
+	next := &mockTransport{handler: func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	}}
+	transport := &timeoutTransport{timeout: 10 * time.Millisecond, next: next}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.example.com/things", nil)
+	_, err := transport.RoundTrip(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// TestNewHTTPClient_QueuedRequestsDontTimeOut queues more requests than a 1 rps limiter
+// can start within requestTimeout. The wait for a token must not count against it.
+func TestNewHTTPClient_QueuedRequestsDontTimeOut(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := newHTTPClient("token", "ua", Limits{RequestsPerSecond: 1})
+	requests := int(requestTimeout/time.Second) + 2
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+			resp, err := c.Do(req)
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
 }
 
 func TestBuildUserAgent_FormatAndFallbacks(t *testing.T) {
@@ -155,7 +190,7 @@ func TestAuthTransport_SetsUserAgentOnWire(t *testing.T) {
 	}))
 	defer server.Close()
 
-	c := newHTTPClient("token", wantUA)
+	c := newHTTPClient("token", wantUA, Limits{})
 	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
 	require.NoError(t, err)
 	resp, err := c.Do(req)
@@ -164,3 +199,121 @@ func TestAuthTransport_SetsUserAgentOnWire(t *testing.T) {
 
 	assert.Equal(t, wantUA, gotUA)
 }
+
+func TestNewLimitTransport_NoLimitsReturnsNext(t *testing.T) {
+	next := &mockTransport{}
+	assert.Same(t, next, newLimitTransport(Limits{}, next))
+}
+
+func TestLimitTransport_CapsConcurrency(t *testing.T) {
+	const maxConcurrent = 3
+	var mu sync.Mutex
+	inFlight, peak := 0, 0
+	next := &mockTransport{handler: func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		inFlight++
+		peak = max(peak, inFlight)
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		return &http.Response{StatusCode: 200, Body: http.NoBody}, nil
+	}}
+	transport := newLimitTransport(Limits{MaxConcurrentRequests: maxConcurrent}, next)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 12; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, "https://api.example.com/things", nil)
+			resp, err := transport.RoundTrip(req)
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, peak, maxConcurrent)
+}
+
+func TestLimitTransport_HoldsSlotUntilBodyClosed(t *testing.T) {
+	next := &mockTransport{handler: func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	}}
+	transport := newLimitTransport(Limits{MaxConcurrentRequests: 1}, next)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.example.com/things", nil)
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+
+	// The only slot is held by the unread body, so a second request must wait.
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	blocked, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.example.com/things", nil)
+	_, err = transport.RoundTrip(blocked)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Closing twice must not release the slot twice.
+	require.NoError(t, resp.Body.Close())
+	require.NoError(t, resp.Body.Close())
+	resp, err = transport.RoundTrip(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+}
+
+func TestLimitTransport_ReleasesSlotOnError(t *testing.T) {
+	next := &mockTransport{handler: func(req *http.Request) (*http.Response, error) {
+		return nil, io.ErrUnexpectedEOF
+	}}
+	transport := newLimitTransport(Limits{MaxConcurrentRequests: 1}, next)
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequest(http.MethodGet, "https://api.example.com/things", nil)
+		_, err := transport.RoundTrip(req)
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	}
+}
+
+func TestLimitTransport_RateLimits(t *testing.T) {
+	next := &mockTransport{handler: func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: 200, Body: http.NoBody}, nil
+	}}
+	// Burst of 50, then one token every 20ms.
+	transport := newLimitTransport(Limits{RequestsPerSecond: 50}, next)
+
+	start := time.Now()
+	for i := 0; i < 55; i++ {
+		req, _ := http.NewRequest(http.MethodGet, "https://api.example.com/things", nil)
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 80*time.Millisecond)
+}
+
+func TestLimitTransport_RateLimitHonorsContext(t *testing.T) {
+	next := &mockTransport{handler: func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: 200, Body: http.NoBody}, nil
+	}}
+	transport := newLimitTransport(Limits{RequestsPerSecond: 0.01, MaxConcurrentRequests: 1}, next)
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.example.com/things", nil)
+	resp, err := transport.RoundTrip(req) // consumes the only token
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ = http.NewRequestWithContext(ctx, http.MethodGet, "https://api.example.com/things", nil)
+	_, err = transport.RoundTrip(req)
+	require.Error(t, err)
+
+	// The failed wait must hand its concurrency slot back.
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ = http.NewRequestWithContext(ctx, http.MethodGet, "https://api.example.com/things", nil)
+	_, err = transport.RoundTrip(req)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, context.DeadlineExceeded, "should fail in the rate limiter, not block on the slot")
+}
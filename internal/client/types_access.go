@@ -0,0 +1,66 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+// RoleInput is the POST/PUT body of the /roles endpoints. LogTypeAccessKind is
+// ALLOW_ALL, ALLOW or DENY: with ALLOW or DENY, members can read only (or all but)
+// the LogTypeAccess log types; with ALLOW_ALL, LogTypeAccess is empty.
+type RoleInput struct {
+	Name              string   `json:"name"`
+	Permissions       []string `json:"permissions"`
+	LogTypeAccessKind string   `json:"logTypeAccessKind"`
+	LogTypeAccess     []string `json:"logTypeAccess"`
+}
+
+// Role is the response body of the /roles endpoints.
+type Role struct {
+	ID string `json:"id"`
+	RoleInput
+}
+
+// UserInput is the POST/PUT body of the /users endpoints. Creating a user sends them
+// an invitation email; Email can't be changed afterwards.
+type UserInput struct {
+	Email      string `json:"email"`
+	GivenName  string `json:"givenName"`
+	FamilyName string `json:"familyName"`
+	RoleID     string `json:"roleId"`
+}
+
+// User is the response body of the /users endpoints.
+type User struct {
+	ID string `json:"id"`
+	UserInput
+}
+
+// APITokenInput is the POST/PUT body of the /api-tokens endpoints. An empty
+// AllowedCIDRBlocks allows any source address; an empty ExpiresAt (RFC 3339) never
+// expires. ExpiresAt can't be changed after creation.
+type APITokenInput struct {
+	Name              string   `json:"name"`
+	Permissions       []string `json:"permissions"`
+	AllowedCIDRBlocks []string `json:"allowedCIDRBlocks"`
+	ExpiresAt         string   `json:"expiresAt,omitempty"`
+}
+
+// APIToken is the response body of the /api-tokens endpoints. Value, the secret sent
+// as X-API-Key, is only returned by POST.
+type APIToken struct {
+	ID string `json:"id"`
+	APITokenInput
+	Value string `json:"value,omitempty"`
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+// AzureBlobSource represents an Azure Blob Storage log source integration (API response).
+type AzureBlobSource struct {
+	IntegrationId string `json:"integrationId"`
+	AzureBlobSourceInput
+}
+
+// AzureBlobSourceInput is the request body for creating or updating an Azure Blob Storage
+// log source.
+type AzureBlobSourceInput struct {
+	IntegrationLabel     string                     `json:"integrationLabel"`
+	TenantId             string                     `json:"tenantId"`
+	ClientId             string                     `json:"clientId"`
+	ClientSecret         string                     `json:"clientSecret,omitempty"`
+	StorageAccountName   string                     `json:"storageAccountName"`
+	ContainerName        string                     `json:"containerName"`
+	LogStreamType        string                     `json:"logStreamType"`
+	LogStreamTypeOptions *AzureLogStreamTypeOptions `json:"logStreamTypeOptions,omitempty"`
+	PrefixLogTypes       []AzurePrefixLogTypesInput `json:"prefixLogTypes"`
+}
+
+// AzureEventHubSource represents an Azure Event Hub log source integration (API response).
+type AzureEventHubSource struct {
+	IntegrationId string `json:"integrationId"`
+	AzureEventHubSourceInput
+}
+
+// AzureEventHubSourceInput is the request body for creating or updating an Azure Event Hub
+// log source.
+type AzureEventHubSourceInput struct {
+	IntegrationLabel     string                     `json:"integrationLabel"`
+	TenantId             string                     `json:"tenantId"`
+	ClientId             string                     `json:"clientId"`
+	ClientSecret         string                     `json:"clientSecret,omitempty"`
+	Namespace            string                     `json:"namespace"`
+	EventHubName         string                     `json:"eventHubName"`
+	ConsumerGroup        string                     `json:"consumerGroup"`
+	LogTypes             []string                   `json:"logTypes"`
+	LogStreamType        string                     `json:"logStreamType"`
+	LogStreamTypeOptions *AzureLogStreamTypeOptions `json:"logStreamTypeOptions,omitempty"`
+}
+
+// AzureLogStreamTypeOptions contains options specific to the log stream type for Azure sources.
+type AzureLogStreamTypeOptions struct {
+	JsonArrayEnvelopeField string `json:"jsonArrayEnvelopeField,omitempty"`
+	XmlRootElement         string `json:"xmlRootElement,omitempty"`
+}
+
+// AzurePrefixLogTypesInput represents a blob name prefix-to-log-types mapping for an Azure
+// Blob Storage source.
+type AzurePrefixLogTypesInput struct {
+	Prefix           string   `json:"prefix"`
+	LogTypes         []string `json:"logTypes"`
+	ExcludedPrefixes []string `json:"excludedPrefixes"`
+}
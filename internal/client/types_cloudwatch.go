@@ -0,0 +1,36 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+// CloudWatchSource represents a CloudWatch Logs log source integration (API response).
+type CloudWatchSource struct {
+	IntegrationId string `json:"integrationId"`
+	CloudWatchSourceInput
+}
+
+// CloudWatchSourceInput is the request body for creating or updating a CloudWatch Logs
+// log source. AwsAccountId, Region and LogGroupName can't be changed after creation.
+type CloudWatchSourceInput struct {
+	IntegrationLabel    string   `json:"integrationLabel"`
+	AwsAccountId        string   `json:"awsAccountId"`
+	Region              string   `json:"region"`
+	LogGroupName        string   `json:"logGroupName"`
+	FilterPattern       string   `json:"filterPattern"`
+	ManagedSubscription bool     `json:"managedSubscription"`
+	LogProcessingRole   string   `json:"logProcessingRole"`
+	LogTypes            []string `json:"logTypes"`
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+// DestinationCore holds the fields every alert destination shares: its name and the
+// filters deciding which alerts it receives. An empty LogTypes matches every log type.
+type DestinationCore struct {
+	ID          string   `json:"id"`
+	DisplayName string   `json:"displayName"`
+	Severities  []string `json:"severities"`
+	AlertTypes  []string `json:"alertTypes"`
+	LogTypes    []string `json:"logTypes"`
+}
+
+// The per-kind bodies of the /destinations/{kind} endpoints. The same body is sent on
+// POST and PUT and returned by every method, except that secrets (webhook URLs, keys
+// and tokens) are write-only: the API returns them as "".
+
+// SlackDestination posts alerts to a Slack incoming webhook.
+type SlackDestination struct {
+	DestinationCore
+	WebhookURL string `json:"webhookUrl"`
+}
+
+// PagerDutyDestination triggers PagerDuty incidents through an Events API v2 integration.
+type PagerDutyDestination struct {
+	DestinationCore
+	IntegrationKey string `json:"integrationKey"`
+}
+
+// WebhookDestination POSTs the alert JSON to an arbitrary URL.
+type WebhookDestination struct {
+	DestinationCore
+	URL string `json:"url"`
+}
+
+// SNSDestination publishes alerts to an SNS topic. Panther needs permission to publish
+// to the topic; there is no secret.
+type SNSDestination struct {
+	DestinationCore
+	TopicARN string `json:"topicArn"`
+}
+
+// JiraDestination files alerts as Jira issues.
+type JiraDestination struct {
+	DestinationCore
+	OrgDomain  string   `json:"orgDomain"`
+	ProjectKey string   `json:"projectKey"`
+	UserName   string   `json:"userName"`
+	APIKey     string   `json:"apiKey"`
+	AssigneeID string   `json:"assigneeId"`
+	IssueType  string   `json:"issueType"`
+	Labels     []string `json:"labels"`
+}
+
+// OpsgenieDestination creates Opsgenie alerts.
+type OpsgenieDestination struct {
+	DestinationCore
+	APIKey        string `json:"apiKey"`
+	ServiceRegion string `json:"serviceRegion"`
+}
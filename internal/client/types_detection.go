@@ -0,0 +1,97 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+// DetectionCore holds the fields rules, scheduled rules and policies share. The same
+// body is sent on POST and PUT and returned by every method; ID is user-chosen and
+// immutable (PUT takes it from the path). OutputIDs, when non-empty, sends the
+// detection's alerts to those destinations instead of the ones whose filters match.
+type DetectionCore struct {
+	ID          string              `json:"id"`
+	DisplayName string              `json:"displayName"`
+	Body        string              `json:"body"`
+	Description string              `json:"description"`
+	Severity    string              `json:"severity"`
+	Enabled     bool                `json:"enabled"`
+	Tags        []string            `json:"tags"`
+	Runbook     string              `json:"runbook"`
+	Reference   string              `json:"reference"`
+	Reports     map[string][]string `json:"reports"`
+	Tests       []DetectionTest     `json:"tests"`
+	OutputIDs   []string            `json:"outputIds"`
+}
+
+// DetectionTest is a unit test stored with a detection. Resource is the JSON-encoded
+// event (or, for policies, cloud resource) the detection is run against; Panther runs
+// the tests on save and rejects the detection if any fail.
+type DetectionTest struct {
+	Name           string `json:"name"`
+	ExpectedResult bool   `json:"expectedResult"`
+	Resource       string `json:"resource"`
+}
+
+// Rule is the body of the /rules endpoints: a streaming detection over log types.
+type Rule struct {
+	DetectionCore
+	LogTypes           []string `json:"logTypes"`
+	DedupPeriodMinutes int64    `json:"dedupPeriodMinutes"`
+	Threshold          int64    `json:"threshold"`
+}
+
+// ScheduledRule is the body of the /scheduled-rules endpoints: a detection over the
+// results of one or more scheduled queries.
+type ScheduledRule struct {
+	DetectionCore
+	ScheduledQueries   []string `json:"scheduledQueries"`
+	DedupPeriodMinutes int64    `json:"dedupPeriodMinutes"`
+	Threshold          int64    `json:"threshold"`
+}
+
+// Policy is the body of the /policies endpoints: a detection over cloud resources.
+type Policy struct {
+	DetectionCore
+	ResourceTypes []string `json:"resourceTypes"`
+}
+
+// GlobalHelper is the body of the /globals endpoints: a Python module detections can
+// import by its ID. ID is user-chosen and immutable, like a detection's.
+type GlobalHelper struct {
+	ID          string `json:"id"`
+	Body        string `json:"body"`
+	Description string `json:"description"`
+}
+
+// DataModelMapping maps a unified field name to a field of the log type, given either
+// as a JSON Path or as the name of a function defined in the data model's body.
+// Exactly one of Path and Method is set.
+type DataModelMapping struct {
+	Name   string `json:"name"`
+	Path   string `json:"path,omitempty"`
+	Method string `json:"method,omitempty"`
+}
+
+// DataModel is the body of the /data-models endpoints. LogTypes holds the single log
+// type the model applies to; a log type has at most one enabled data model. Body is
+// the Python source defining the Method mappings' functions, empty if there are none.
+type DataModel struct {
+	ID          string             `json:"id"`
+	DisplayName string             `json:"displayName"`
+	Enabled     bool               `json:"enabled"`
+	LogTypes    []string           `json:"logTypes"`
+	Mappings    []DataModelMapping `json:"mappings"`
+	Body        string             `json:"body"`
+}
@@ -0,0 +1,33 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+// EventBridgeSource represents an EventBridge log source integration (API response).
+type EventBridgeSource struct {
+	IntegrationId string `json:"integrationId"`
+	EventBridgeSourceInput
+}
+
+// EventBridgeSourceInput is the request body for creating or updating an EventBridge log
+// source. AwsAccountId, Region and BusName can't be changed after creation.
+type EventBridgeSourceInput struct {
+	IntegrationLabel string   `json:"integrationLabel"`
+	AwsAccountId     string   `json:"awsAccountId"`
+	Region           string   `json:"region"`
+	BusName          string   `json:"busName"`
+	LogTypes         []string `json:"logTypes"`
+}
@@ -16,18 +16,34 @@ limitations under the License.
 
 package client
 
-// LogSourceAlarmInput is the PUT request body for creating or updating a log source alarm.
+// LogSourceAlarmInput is the PUT request body for configuring a log source alarm. Which
+// threshold applies depends on the alarm type: MinutesThreshold for SOURCE_NO_DATA, one
+// of PercentageThreshold and CountThreshold for SOURCE_CLASSIFICATION_FAILURES and
+// SOURCE_LOG_PROCESSING_ERRORS, none for the other types. Unused thresholds are omitted.
+// Without DestinationIds the alarm's alerts are routed like any other SYSTEM_ERROR alert.
 type LogSourceAlarmInput struct {
-	MinutesThreshold int64 `json:"minutesThreshold"`
+	MinutesThreshold    int64    `json:"minutesThreshold,omitempty"`
+	PercentageThreshold int64    `json:"percentageThreshold,omitempty"`
+	CountThreshold      int64    `json:"countThreshold,omitempty"`
+	Muted               bool     `json:"muted"`
+	DestinationIds      []string `json:"destinationIds,omitempty"`
 }
 
 // LogSourceAlarm is the API response for GET and PUT on
-// /log-source-alarms/{sourceId}/{type}. The GET response also includes a runtime
+// /log-source-alarms/{sourceId}/{type}, and an element of the GET /log-source-alarms
+// list of every configured alarm. The GET response also includes a runtime
 // `state` field (OK | ALARM | INSUFFICIENT_DATA) which this struct intentionally
-// does NOT mirror — the provider scopes itself to declarative configuration and
-// exposes no runtime observability. Consumers who need live alarm state should
-// GET that endpoint directly or use a dedicated data source (future work).
+// does NOT mirror — the resource scopes itself to declarative configuration.
+// LogSourceAlarmStatus carries the state for the read-only data source.
 type LogSourceAlarm struct {
-	Type string `json:"type"`
+	SourceId string `json:"sourceId"`
+	Type     string `json:"type"`
 	LogSourceAlarmInput
 }
+
+// LogSourceAlarmStatus is the full GET response for /log-source-alarms/{sourceId}/{type},
+// including the runtime alarm state.
+type LogSourceAlarmStatus struct {
+	LogSourceAlarm
+	State string `json:"state"`
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+// LookupTableAssociation links a lookup table to a log type: events of LogType are
+// enriched with the table row whose primary key matches one of the Selectors (JSON
+// paths into the event).
+type LookupTableAssociation struct {
+	LogType   string   `json:"logType"`
+	Selectors []string `json:"selectors"`
+}
+
+// LookupTableRefresh makes Panther load a lookup table's data from an S3 object,
+// re-reading it every PeriodMinutes using the role RoleARN.
+type LookupTableRefresh struct {
+	S3ObjectPath  string `json:"s3ObjectPath"`
+	RoleARN       string `json:"roleArn"`
+	PeriodMinutes int64  `json:"periodMinutes"`
+	KMSKeyARN     string `json:"kmsKeyArn"`
+}
+
+// LookupTableInput is the POST/PUT body of the /lookup-tables endpoints. LogType is the
+// schema of the table's rows. A table without Refresh takes its data from uploads to
+// /lookup-tables/{id}/data.
+type LookupTableInput struct {
+	Name               string                   `json:"name"`
+	Description        string                   `json:"description"`
+	Enabled            bool                     `json:"enabled"`
+	LogType            string                   `json:"logType"`
+	PrimaryKey         string                   `json:"primaryKey"`
+	AssociatedLogTypes []LookupTableAssociation `json:"associatedLogTypes"`
+	Refresh            *LookupTableRefresh      `json:"refresh,omitempty"`
+}
+
+// LookupTable is the response body of the /lookup-tables endpoints. DataHash is the
+// hex SHA-256 of the last uploaded data, empty if none was uploaded.
+type LookupTable struct {
+	ID string `json:"id"`
+	LookupTableInput
+	DataHash string `json:"dataHash"`
+}
+
+// LookupTableDataInput is the PUT body of /lookup-tables/{id}/data: the table's rows,
+// as JSON lines or CSV, replacing any previous upload.
+type LookupTableDataInput struct {
+	Data string `json:"data"`
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+// SavedQueryInput is the POST/PUT body of the /saved-queries endpoints.
+type SavedQueryInput struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	SQL         string `json:"sql"`
+}
+
+// SavedQuery is the response body of the /saved-queries endpoints.
+type SavedQuery struct {
+	ID string `json:"id"`
+	SavedQueryInput
+}
+
+// ScheduledQueryInput is the POST/PUT body of the /scheduled-queries endpoints. Exactly
+// one of CronExpression and RateMinutes is set; the other is omitted.
+type ScheduledQueryInput struct {
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	SQL            string `json:"sql"`
+	CronExpression string `json:"cronExpression,omitempty"`
+	RateMinutes    int64  `json:"rateMinutes,omitempty"`
+	TimeoutMinutes int64  `json:"timeoutMinutes"`
+	Enabled        bool   `json:"enabled"`
+}
+
+// ScheduledQuery is the response body of the /scheduled-queries endpoints.
+type ScheduledQuery struct {
+	ID string `json:"id"`
+	ScheduledQueryInput
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+// SaasSourceCore holds the fields every SaaS log puller shares. Panther picks the log
+// types from the integration, so LogTypes is only ever set in responses.
+type SaasSourceCore struct {
+	IntegrationId    string   `json:"integrationId"`
+	IntegrationLabel string   `json:"integrationLabel"`
+	LogTypes         []string `json:"logTypes,omitempty"`
+}
+
+// The per-kind bodies of the /log-sources/{kind} endpoints of the SaaS pullers. The same
+// body is sent on POST and PUT and returned by every method, except that credentials are
+// write-only: the API returns them as "", and keeps the stored value when one is sent
+// empty on PUT. Panther checks credentials with the third party before saving and
+// answers 400 with rejectedCredentials set when they're refused.
+
+// OktaSource pulls the System Log of an Okta org.
+type OktaSource struct {
+	SaasSourceCore
+	Domain              string `json:"domain"`
+	ApiToken            string `json:"apiToken,omitempty"`
+	PullIntervalMinutes int64  `json:"pullIntervalMinutes"`
+}
+
+// GitHubSource pulls the audit log of a GitHub organization.
+type GitHubSource struct {
+	SaasSourceCore
+	Organization        string `json:"organization"`
+	PersonalAccessToken string `json:"personalAccessToken,omitempty"`
+	PullIntervalMinutes int64  `json:"pullIntervalMinutes"`
+}
+
+// SlackSource pulls the audit logs of a Slack Enterprise Grid organization.
+type SlackSource struct {
+	SaasSourceCore
+	EnterpriseId        string `json:"enterpriseId"`
+	AccessToken         string `json:"accessToken,omitempty"`
+	PullIntervalMinutes int64  `json:"pullIntervalMinutes"`
+}
+
+// GoogleWorkspaceSource pulls Admin SDK activity reports through a service account with
+// domain-wide delegation. Reports are fetched as Google publishes them, so there is no
+// pull interval.
+type GoogleWorkspaceSource struct {
+	SaasSourceCore
+	CustomerId        string `json:"customerId"`
+	AdminEmail        string `json:"adminEmail"`
+	ServiceAccountKey string `json:"serviceAccountKey,omitempty"`
+}
+
+// OnePasswordSource pulls sign-in attempts, item usage and audit events from the
+// 1Password Events API.
+type OnePasswordSource struct {
+	SaasSourceCore
+	EventsApiHost       string `json:"eventsApiHost"`
+	BearerToken         string `json:"bearerToken,omitempty"`
+	PullIntervalMinutes int64  `json:"pullIntervalMinutes"`
+}
+
+// Microsoft365Source subscribes to the Office 365 Management Activity API as a Microsoft
+// Entra app registration. Content is fetched as Microsoft publishes it, so there is no
+// pull interval.
+type Microsoft365Source struct {
+	SaasSourceCore
+	TenantId     string `json:"tenantId"`
+	ClientId     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret,omitempty"`
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+// SchemasPath is the REST path for log schemas, managed and custom.
+const SchemasPath = "/schemas"
+
+// SchemaInput is the request body for POST /schemas and PUT /schemas/{name}. Schemas
+// can't be deleted, only archived: PUT with IsArchived set archives one, and PUT
+// without it restores an archived schema. Name is ignored on PUT.
+type SchemaInput struct {
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description"`
+	ReferenceURL string `json:"referenceURL"`
+	Spec         string `json:"spec"`
+	IsArchived   bool   `json:"isArchived"`
+}
+
+// Schema is a log schema as returned by the /schemas endpoints. Its Name is the log
+// type that sources reference in log_types. Managed schemas ship with Panther;
+// archived schemas are disabled and can no longer be attached to a source. The list
+// endpoint may omit Spec.
+type Schema struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	ReferenceURL string `json:"referenceURL"`
+	Spec         string `json:"spec,omitempty"`
+	IsManaged    bool   `json:"isManaged"`
+	IsArchived   bool   `json:"isArchived"`
+}
@@ -0,0 +1,33 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+// SqsSource represents an SQS log source integration (API response).
+type SqsSource struct {
+	IntegrationId string `json:"integrationId"`
+	SqsSourceInput
+	QueueUrl string `json:"queueUrl"`
+}
+
+// SqsSourceInput is the request body for creating or updating an SQS log source.
+// Panther creates and owns the queue; the ARN lists control who may send to it.
+type SqsSourceInput struct {
+	IntegrationLabel     string   `json:"integrationLabel"`
+	LogTypes             []string `json:"logTypes"`
+	AllowedPrincipalArns []string `json:"allowedPrincipalArns"`
+	AllowedSourceArns    []string `json:"allowedSourceArns"`
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Access management (panther_role, panther_user, panther_api_token) is hand-written;
+// this file holds what the resources share.
+
+const (
+	rolePath     = "/roles"
+	userPath     = "/users"
+	apiTokenPath = "/api-tokens"
+)
+
+// pantherPermissions are the permissions roles and API tokens can be granted.
+var pantherPermissions = []string{
+	"AlertModify", "AlertRead", "BulkUpload", "BulkUploadValidate", "CloudsecSourceModify", "CloudsecSourceRead",
+	"DataAnalyticsModify", "DataAnalyticsRead", "DestinationModify", "DestinationRead", "GeneralSettingsModify",
+	"GeneralSettingsRead", "LogSourceModify", "LogSourceRawDataRead", "LogSourceRead", "LookupModify", "LookupRead",
+	"OrganizationAPITokenModify", "OrganizationAPITokenRead", "PolicyModify", "PolicyRead", "ResourceModify",
+	"ResourceRead", "RuleModify", "RuleRead", "SummaryRead", "UserModify", "UserRead",
+}
+
+// permissionsAttribute is a required, non-empty set of permissions, as a list.
+func permissionsAttribute(description string) schema.ListAttribute {
+	return schema.ListAttribute{
+		ElementType:         types.StringType,
+		Required:            true,
+		MarkdownDescription: description + " Any of `" + strings.Join(pantherPermissions, "`, `") + "`.",
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.UniqueValues(),
+			listvalidator.ValueStringsAre(stringvalidator.OneOf(pantherPermissions...)),
+		},
+	}
+}
+
+// cidrBlock is a string validator rejecting values that aren't an IPv4 or IPv6 CIDR
+// block.
+type cidrBlock struct{}
+
+func (cidrBlock) Description(_ context.Context) string {
+	return "must be a CIDR block, e.g. 203.0.113.0/24"
+}
+
+func (v cidrBlock) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (cidrBlock) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, _, err := net.ParseCIDR(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid CIDR Block",
+			fmt.Sprintf("%q is not a CIDR block (e.g. 203.0.113.0/24): %s", req.ConfigValue.ValueString(), err))
+	}
+}
+
+// rfc3339Timestamp is a string validator rejecting values that aren't an RFC 3339
+// timestamp, the format of timestamp() and timeadd().
+type rfc3339Timestamp struct{}
+
+func (rfc3339Timestamp) Description(_ context.Context) string {
+	return "must be an RFC 3339 timestamp, e.g. 2030-01-01T00:00:00Z"
+}
+
+func (v rfc3339Timestamp) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (rfc3339Timestamp) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Timestamp",
+			fmt.Sprintf("%q is not an RFC 3339 timestamp (e.g. 2030-01-01T00:00:00Z): %s", req.ConfigValue.ValueString(), err))
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiTokenPrivateKey is the private data key under which Open passes the token ID to
+// Close.
+const apiTokenPrivateKey = "id"
+
+var (
+	_ ephemeral.EphemeralResource              = (*apiTokenEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure = (*apiTokenEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithClose     = (*apiTokenEphemeralResource)(nil)
+)
+
+func NewAPITokenEphemeralResource() ephemeral.EphemeralResource {
+	return &apiTokenEphemeralResource{}
+}
+
+// apiTokenEphemeralResource creates an API token for the length of one Terraform run
+// and deletes it when Terraform closes the resource, so the secret is never written to
+// state or plan. It shares apiTokenModel with the managed panther_api_token.
+type apiTokenEphemeralResource struct {
+	rest *client.RESTClient
+}
+
+func (r *apiTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *apiTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	permissions := permissionsAttribute("What the token can do.")
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a Panther API token that lives only for the Terraform run. Requires Terraform 1.10 or later.\n\n" +
+			"The token is created when Terraform opens the ephemeral resource and deleted when it closes it, " +
+			"so `value` is never stored in state or plan. Use it to pass a short-lived credential to another " +
+			"provider or to a write-only attribute in the same run. For a token that outlives the run, use the " +
+			"`panther_api_token` resource, which keeps its secret in state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The token ID.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The token name, unique in the instance while the token exists.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"permissions": schema.ListAttribute{
+				ElementType:         permissions.ElementType,
+				Required:            true,
+				MarkdownDescription: permissions.MarkdownDescription,
+				Validators:          permissions.Validators,
+			},
+			"allowed_cidr_blocks": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "The source addresses the token can be used from. Unset or empty allows any address.",
+				Validators:  []validator.List{listvalidator.ValueStringsAre(cidrBlock{})},
+			},
+			"expires_at": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "When the token stops working, as an RFC 3339 timestamp (e.g. `2030-01-01T00:00:00Z`), " +
+					"in case Terraform exits before it can delete the token.",
+				Validators: []validator.String{rfc3339Timestamp{}},
+			},
+			"value": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret to send as the `X-API-Key` header.",
+			},
+		},
+	}
+}
+
+func (r *apiTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.rest = ephemeralRESTClient(req, resp)
+}
+
+func (r *apiTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data apiTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := data.toAPI(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := client.RestDo[client.APIToken](ctx, r.rest, http.MethodPost, apiTokenPath, input)
+	if err != nil {
+		if !addAuthDiagnostic(&resp.Diagnostics, err) {
+			resp.Diagnostics.AddError("Error creating API Token", "Could not create API Token: "+err.Error())
+		}
+		return
+	}
+	tflog.Debug(ctx, "Created ephemeral API Token", map[string]any{"id": token.ID})
+
+	id, _ := json.Marshal(token.ID)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiTokenPrivateKey, id)...)
+	data.Value = types.StringValue(token.Value)
+	data.set(ctx, token, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Close deletes the token Open created. A token already deleted outside Terraform is
+// not an error.
+func (r *apiTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	raw, diags := req.Private.GetKey(ctx, apiTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+	var id string
+	if err := json.Unmarshal(raw, &id); err != nil {
+		resp.Diagnostics.AddError("Error deleting API Token", fmt.Sprintf("Could not read the token ID from private data: %s", err))
+		return
+	}
+
+	err := client.RestDelete(ctx, r.rest, apiTokenPath+"/"+id)
+	if err != nil && !client.IsNotFound(err) {
+		if !addAuthDiagnostic(&resp.Diagnostics, err) {
+			resp.Diagnostics.AddError("Error deleting API Token",
+				fmt.Sprintf("Could not delete API Token (id=%s): %s. Delete it in the Panther console.", id, err))
+		}
+		return
+	}
+	tflog.Debug(ctx, "Deleted ephemeral API Token", map[string]any{"id": id})
+}
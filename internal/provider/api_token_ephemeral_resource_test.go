@@ -0,0 +1,90 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestAPITokenEphemeralResource opens a token and hands it to the echo provider, then
+// checks that the token was deleted when Terraform closed the ephemeral resource.
+func TestAPITokenEphemeralResource(t *testing.T) {
+	name := "test-ephemeral-token-" + uuid.NewString()
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"panther": providerserver.NewProtocol6WithError(New("test")()),
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+ephemeral "panther_api_token" "test" {
+  name        = %q
+  permissions = ["RuleRead"]
+}
+
+provider "echo" {
+  data = ephemeral.panther_api_token.test
+}
+
+resource "echo" "test" {}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.test", "data.name", name),
+					resource.TestCheckResourceAttr("echo.test", "data.allowed_cidr_blocks.#", "0"),
+					resource.TestMatchResourceAttr("echo.test", "data.value", regexp.MustCompile(`.+`)),
+					checkAPITokenDeleted("echo.test"),
+				),
+			},
+		},
+	})
+}
+
+// checkAPITokenDeleted checks that the token whose ID the echo resource holds no longer
+// exists.
+func checkAPITokenDeleted(address string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id := s.RootModule().Resources[address].Primary.Attributes["data.id"]
+		if id == "" {
+			return fmt.Errorf("data.id is empty")
+		}
+		c := client.NewRESTClient(os.Getenv("PANTHER_API_URL"), os.Getenv("PANTHER_API_TOKEN"), testUserAgent)
+		_, err := client.RestDo[client.APIToken](context.Background(), c, http.MethodGet, apiTokenPath+"/"+id, nil)
+		if !client.IsNotFound(err) {
+			return fmt.Errorf("API token %s still exists after close (err=%v)", id, err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*apiTokenResource)(nil)
+	_ resource.ResourceWithConfigure   = (*apiTokenResource)(nil)
+	_ resource.ResourceWithImportState = (*apiTokenResource)(nil)
+)
+
+func NewAPITokenResource() resource.Resource {
+	return &apiTokenResource{}
+}
+
+type apiTokenResource struct {
+	rest *client.RESTClient
+}
+
+type apiTokenModel struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Permissions       types.List   `tfsdk:"permissions"`
+	AllowedCidrBlocks types.List   `tfsdk:"allowed_cidr_blocks"`
+	ExpiresAt         types.String `tfsdk:"expires_at"`
+	Value             types.String `tfsdk:"value"`
+}
+
+func (r *apiTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *apiTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Panther API token.\n\n" +
+			"**The token secret is stored in state.** The API returns `value` once, when the token is created, " +
+			"and never again, so this resource keeps it in state, marked sensitive, for as long as the token exists. " +
+			"Anyone who can read the state can use the token. Keep the state in an encrypted backend with restricted " +
+			"access, limit the token with `permissions`, `allowed_cidr_blocks` and `expires_at`, and copy `value` to a " +
+			"secret store rather than reading it from state elsewhere. Rotating a token means replacing it, e.g. with " +
+			"`terraform apply -replace`.\n\n" +
+			"If the token is only needed during the run, use the `panther_api_token` ephemeral resource instead " +
+			"(Terraform 1.10 or later). It takes the same arguments, creates the token when the run starts and " +
+			"deletes it when the run ends, and its `value` is never written to state or plan:\n\n" +
+			"```terraform\n" +
+			"ephemeral \"panther_api_token\" \"deploy\" {\n" +
+			"  name        = \"deploy-run\"\n" +
+			"  permissions = [\"RuleModify\"]\n" +
+			"}\n" +
+			"```",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The token ID.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The token name, unique in the instance.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"permissions": permissionsAttribute("What the token can do."),
+			"allowed_cidr_blocks": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Description: "The source addresses the token can be used from. Empty (the default) allows any address.",
+				Validators:  []validator.List{listvalidator.ValueStringsAre(cidrBlock{})},
+			},
+			"expires_at": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "When the token stops working, as an RFC 3339 timestamp (e.g. `2030-01-01T00:00:00Z`). " +
+					"Use a fixed value: one derived from `timestamp()` changes on every plan and would replace the token. " +
+					"Unset never expires. Changing this forces a new token.",
+				Validators:    []validator.String{rfc3339Timestamp{}},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"value": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				MarkdownDescription: "The secret to send as the `X-API-Key` header. Only known after the token is " +
+					"created, and null for an imported token. Kept in state until the token is destroyed.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *apiTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+func (r *apiTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data apiTokenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := data.toAPI(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := client.RestDo[client.APIToken](ctx, r.rest, http.MethodPost, apiTokenPath, input)
+	if handleCreateError(resp, "API Token", err) {
+		return
+	}
+	tflog.Debug(ctx, "Created API Token", map[string]any{"id": token.ID})
+
+	data.Value = types.StringValue(token.Value)
+	data.set(ctx, token, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *apiTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data apiTokenModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := client.RestDo[client.APIToken](ctx, r.rest, http.MethodGet, apiTokenPath+"/"+data.Id.ValueString(), nil)
+	if handleReadError(ctx, resp, "API Token", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Read API Token", map[string]any{"id": token.ID})
+
+	data.set(ctx, token, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *apiTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data apiTokenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := data.toAPI(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := client.RestDo[client.APIToken](ctx, r.rest, http.MethodPut, apiTokenPath+"/"+data.Id.ValueString(), input)
+	if handleUpdateError(ctx, resp, "API Token", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated API Token", map[string]any{"id": data.Id.ValueString()})
+
+	data.set(ctx, token, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *apiTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data apiTokenModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RestDelete(ctx, r.rest, apiTokenPath+"/"+data.Id.ValueString())
+	if handleDeleteError(resp, "API Token", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Deleted API Token", map[string]any{"id": data.Id.ValueString()})
+}
+
+func (r *apiTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m apiTokenModel) toAPI(ctx context.Context, diagnostics *diag.Diagnostics) client.APITokenInput {
+	return client.APITokenInput{
+		Name:              m.Name.ValueString(),
+		Permissions:       nonNilStrings(listToStringSlice(ctx, m.Permissions, diagnostics)),
+		AllowedCIDRBlocks: nonNilStrings(listToStringSlice(ctx, m.AllowedCidrBlocks, diagnostics)),
+		ExpiresAt:         m.ExpiresAt.ValueString(),
+	}
+}
+
+// set copies an API response into the model. value is left alone: the API only returns
+// it from create, which sets it before calling set. expires_at keeps the configured text
+// when it names the same instant, since the API may change the offset or precision.
+func (m *apiTokenModel) set(ctx context.Context, token client.APIToken, diagnostics *diag.Diagnostics) {
+	m.Id = types.StringValue(token.ID)
+	m.Name = types.StringValue(token.Name)
+	m.Permissions = stringSliceToList(ctx, nonNilStrings(token.Permissions), diagnostics)
+	m.AllowedCidrBlocks = stringSliceToList(ctx, nonNilStrings(token.AllowedCIDRBlocks), diagnostics)
+	if !sameTimestamp(m.ExpiresAt, token.ExpiresAt) {
+		m.ExpiresAt = optionalString(token.ExpiresAt)
+	}
+}
+
+// sameTimestamp reports whether current and returned are RFC 3339 timestamps for the
+// same instant.
+func sameTimestamp(current types.String, returned string) bool {
+	if current.IsNull() || current.IsUnknown() {
+		return false
+	}
+	a, err := time.Parse(time.RFC3339, current.ValueString())
+	if err != nil {
+		return false
+	}
+	b, err := time.Parse(time.RFC3339, returned)
+	return err == nil && a.Equal(b)
+}
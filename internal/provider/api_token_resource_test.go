@@ -0,0 +1,159 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestAPITokenResource covers create, import (which can't recover the secret), an
+// in-place update that must keep the secret, and an expiry change that must replace
+// the token and so issue a new secret.
+func TestAPITokenResource(t *testing.T) {
+	name := "test-token-" + uuid.NewString()
+	config := func(permissions, extra string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "panther_api_token" "test" {
+  name        = %q
+  permissions = %s
+  %s
+}
+`, name, permissions, extra)
+	}
+	var value string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`["RuleRead"]`, `expires_at = "2099-01-01T00:00:00Z"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("panther_api_token.test", "id"),
+					resource.TestCheckResourceAttr("panther_api_token.test", "allowed_cidr_blocks.#", "0"),
+					resource.TestMatchResourceAttr("panther_api_token.test", "value", regexp.MustCompile(`.+`)),
+					saveAPITokenValue(&value),
+				),
+			},
+			{
+				ResourceName:            "panther_api_token.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value", "expires_at"}, // the API's formatting of expires_at may differ
+			},
+			{
+				Config: config(`["RuleRead", "RuleModify"]`, `
+  expires_at          = "2099-01-01T00:00:00Z"
+  allowed_cidr_blocks = ["203.0.113.0/24", "2001:db8::/32"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_api_token.test", "permissions.#", "2"),
+					resource.TestCheckResourceAttr("panther_api_token.test", "allowed_cidr_blocks.1", "2001:db8::/32"),
+					checkAPITokenValue(&value, true),
+				),
+			},
+			{
+				Config: config(`["RuleRead", "RuleModify"]`, `expires_at = "2098-01-01T00:00:00Z"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_api_token.test", "expires_at", "2098-01-01T00:00:00Z"),
+					checkAPITokenValue(&value, false),
+				),
+			},
+		},
+	})
+}
+
+// TestAPITokenResource_ExpiresAtFormat checks that expires_at keeps its configured text
+// when the API returns the same instant in another offset or precision (the fake API
+// returns UTC with milliseconds).
+func TestAPITokenResource_ExpiresAtFormat(t *testing.T) {
+	config := providerConfig + fmt.Sprintf(`
+resource "panther_api_token" "test" {
+  name        = %q
+  permissions = ["RuleRead"]
+  expires_at  = "2099-01-01T01:00:00+01:00"
+}
+`, "test-token-"+uuid.NewString())
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("panther_api_token.test", "expires_at", "2099-01-01T01:00:00+01:00"),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func saveAPITokenValue(value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		*value = s.RootModule().Resources["panther_api_token.test"].Primary.Attributes["value"]
+		return nil
+	}
+}
+
+// checkAPITokenValue checks whether the token's secret is still the saved one.
+func checkAPITokenValue(value *string, same bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		current := s.RootModule().Resources["panther_api_token.test"].Primary.Attributes["value"]
+		if current == "" {
+			return fmt.Errorf("value is empty")
+		}
+		if (current == *value) != same {
+			return fmt.Errorf("value changed: %t, want %t", current != *value, !same)
+		}
+		return nil
+	}
+}
+
+// TestAPITokenResource_PlanTimeValidation covers errors raised before any API call.
+func TestAPITokenResource_PlanTimeValidation(t *testing.T) {
+	cases := map[string]struct {
+		extra string
+		err   string
+	}{
+		"bad_cidr":       {extra: `allowed_cidr_blocks = ["203.0.113.0"]`, err: `Invalid CIDR Block`},
+		"bad_expires_at": {extra: `expires_at = "2099-01-01"`, err: `Invalid Timestamp`},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_api_token" "test" {
+  name        = "plan-time-validation"
+  permissions = ["RuleRead"]
+  %s
+}
+`, tc.extra),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.err),
+					},
+				},
+			})
+		})
+	}
+}
@@ -19,12 +19,10 @@ package provider
 import (
 	"context"
 	"net/http"
-	"regexp"
 
 	"terraform-provider-panther/internal/client"
 	"terraform-provider-panther/internal/provider/resource_aws_cloud_account"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -37,11 +35,6 @@ import (
 
 const awsCloudAccountPath = "/cloud-accounts/aws"
 
-var (
-	auditRoleARNRegex = regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:role/.+$`)
-	awsRegionRegex    = regexp.MustCompile(`^[a-z]{2}(?:-gov)?-[a-z]+-\d+$`)
-)
-
 var (
 	_ resource.Resource                = (*awsCloudAccountResource)(nil)
 	_ resource.ResourceWithConfigure   = (*awsCloudAccountResource)(nil)
@@ -91,14 +84,8 @@ func (r *awsCloudAccountResource) Schema(ctx context.Context, req resource.Schem
 	// codegen bug), region_ignore_list items pattern (current codegen doesn't
 	// lift items.pattern), and resource_regex_ignore_list "compiles as regex"
 	// (no OpenAPI primitive).
-	addNestedStringValidator(&resp.Schema, "aws_scan_config", "audit_role",
-		stringvalidator.RegexMatches(auditRoleARNRegex,
-			"must be a valid IAM role ARN (e.g. arn:aws:iam::123456789012:role/PantherAuditRole)"),
-	)
-	addListElementValidator(&resp.Schema, "region_ignore_list",
-		stringvalidator.RegexMatches(awsRegionRegex,
-			"must be a valid AWS region code (e.g. us-east-1, us-gov-west-1)"),
-	)
+	addNestedStringValidator(&resp.Schema, "aws_scan_config", "audit_role", iamRoleARNValidator("PantherAuditRole"))
+	addListElementValidator(&resp.Schema, "region_ignore_list", awsRegionValidator())
 	addListElementValidator(&resp.Schema, "resource_regex_ignore_list", compilesAsRegex{})
 }
 
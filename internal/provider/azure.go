@@ -0,0 +1,61 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Shared plumbing for the Azure log sources (panther_azure_blob_source,
+// panther_azure_eventhub_source). Both authenticate as a Microsoft Entra app
+// registration: tenant_id and client_id identify it, and client_secret is handled
+// like the other source secrets, sensitive in state with a client_secret_wo
+// alternative that never reaches it.
+
+const (
+	azureBlobSourcePath     = "/log-sources/azure-blob"
+	azureEventHubSourcePath = "/log-sources/azure-eventhub"
+)
+
+// Azure resource names, checked at plan time against Azure's naming rules.
+var (
+	azureGUIDRegex              = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	azureStorageAccountRegex    = regexp.MustCompile(`^[a-z0-9]{3,24}$`)
+	azureContainerRegex         = regexp.MustCompile(`^[a-z0-9](?:-?[a-z0-9]){2,62}$`)
+	azureEventHubNamespaceRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9-]{4,48}[A-Za-z0-9]$`)
+	azureEventHubNameRegex      = regexp.MustCompile(`^[A-Za-z0-9](?:[A-Za-z0-9._-]{0,254}[A-Za-z0-9])?$`)
+)
+
+// azureCredentialOverrides are the schema overrides common to the Azure sources.
+func azureCredentialOverrides() []SchemaOverride {
+	return []SchemaOverride{
+		{Name: "id", PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+		{Name: "tenant_id", Validators: []validator.String{
+			stringvalidator.RegexMatches(azureGUIDRegex, "must be a tenant ID (a GUID)"),
+		}},
+		{Name: "client_id", Validators: []validator.String{
+			stringvalidator.RegexMatches(azureGUIDRegex, "must be an application (client) ID (a GUID)"),
+		}},
+		{Name: "client_secret", Default: stringdefault.StaticString(""), Sensitive: true, WriteOnly: true},
+	}
+}
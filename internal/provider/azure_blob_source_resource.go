@@ -0,0 +1,314 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-panther/internal/client"
+	"terraform-provider-panther/internal/provider/resource_azure_blob_source"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                     = (*azureBlobSourceResource)(nil)
+	_ resource.ResourceWithConfigure        = (*azureBlobSourceResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*azureBlobSourceResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*azureBlobSourceResource)(nil)
+	_ resource.ResourceWithImportState      = (*azureBlobSourceResource)(nil)
+)
+
+func NewAzureBlobSourceResource() resource.Resource {
+	return &azureBlobSourceResource{}
+}
+
+type azureBlobSourceResource struct {
+	rest *client.RESTClient
+}
+
+// azureBlobSourceModel is the generated model plus the write-only variants of its
+// secrets. The *Wo fields are only ever set in config; see secretValue.
+type azureBlobSourceModel struct {
+	resource_azure_blob_source.AzureBlobSourceModel
+	ClientSecretWo        types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWoVersion types.Int64  `tfsdk:"client_secret_wo_version"`
+}
+
+func (r *azureBlobSourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_azure_blob_source"
+}
+
+func (r *azureBlobSourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_azure_blob_source.AzureBlobSourceResourceSchema(ctx)
+	resp.Schema.MarkdownDescription = "Represents an Azure Blob Storage Log Source in Panther"
+	applySchemaOverrides(&resp.Schema, append(azureCredentialOverrides(),
+		SchemaOverride{Name: "storage_account_name", Validators: []validator.String{
+			stringvalidator.RegexMatches(azureStorageAccountRegex, "must be a storage account name: 3-24 lowercase letters and digits"),
+		}},
+		SchemaOverride{Name: "container_name", Validators: []validator.String{
+			stringvalidator.RegexMatches(azureContainerRegex,
+				"must be a container name: 3-63 lowercase letters, digits and single hyphens, starting and ending with a letter or digit"),
+		}},
+	))
+
+	// log_stream_type_options: inner string field defaults + null object default
+	logStreamTypeOptions := resp.Schema.Attributes["log_stream_type_options"].(schema.SingleNestedAttribute)
+
+	jsonArrayEnvelopeField := logStreamTypeOptions.Attributes["json_array_envelope_field"].(schema.StringAttribute)
+	jsonArrayEnvelopeField.Default = stringdefault.StaticString("")
+	logStreamTypeOptions.Attributes["json_array_envelope_field"] = jsonArrayEnvelopeField
+
+	xmlRootElement := logStreamTypeOptions.Attributes["xml_root_element"].(schema.StringAttribute)
+	xmlRootElement.Default = stringdefault.StaticString("")
+	logStreamTypeOptions.Attributes["xml_root_element"] = xmlRootElement
+
+	logStreamTypeOptions.Default = objectdefault.StaticValue(types.ObjectNull(
+		resource_azure_blob_source.LogStreamTypeOptionsValue{}.AttributeTypes(ctx),
+	))
+
+	resp.Schema.Attributes["log_stream_type_options"] = logStreamTypeOptions
+
+	// prefix_log_types inner field overrides, as for panther_gcssource
+	prefixLogTypes := resp.Schema.Attributes["prefix_log_types"].(schema.ListNestedAttribute)
+
+	prefix := prefixLogTypes.NestedObject.Attributes["prefix"].(schema.StringAttribute)
+	prefix.Default = stringdefault.StaticString("")
+	prefixLogTypes.NestedObject.Attributes["prefix"] = prefix
+
+	excludedPrefixes := prefixLogTypes.NestedObject.Attributes["excluded_prefixes"].(schema.ListAttribute)
+	excludedPrefixes.Default = listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{}))
+	prefixLogTypes.NestedObject.Attributes["excluded_prefixes"] = excludedPrefixes
+
+	logTypesAttr := prefixLogTypes.NestedObject.Attributes["log_types"].(schema.ListAttribute)
+	logTypesAttr.Required = true
+	logTypesAttr.Optional = false
+	logTypesAttr.Computed = false
+	prefixLogTypes.NestedObject.Attributes["log_types"] = logTypesAttr
+
+	resp.Schema.Attributes["prefix_log_types"] = prefixLogTypes
+}
+
+func (r *azureBlobSourceResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{logStreamTypeOptionsValidator{}}
+}
+
+func (r *azureBlobSourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateLogTypes(ctx, r.rest, req, resp, prefixLogTypesExpression)
+}
+
+func (r *azureBlobSourceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+func (r *azureBlobSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data azureBlobSourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := client.AzureBlobSourceInput{
+		IntegrationLabel:     data.IntegrationLabel.ValueString(),
+		TenantId:             data.TenantId.ValueString(),
+		ClientId:             data.ClientId.ValueString(),
+		ClientSecret:         secretValue(ctx, req.Config, "client_secret", data.ClientSecret, &resp.Diagnostics),
+		StorageAccountName:   data.StorageAccountName.ValueString(),
+		ContainerName:        data.ContainerName.ValueString(),
+		LogStreamType:        data.LogStreamType.ValueString(),
+		LogStreamTypeOptions: azureBlobLogStreamTypeOptions(data.LogStreamTypeOptions),
+		PrefixLogTypes:       azureBlobPrefixLogTypesToInput(ctx, data.PrefixLogTypes, &resp.Diagnostics),
+	}
+
+	blobSource, err := client.RestDo[client.AzureBlobSource](ctx, r.rest, http.MethodPost, azureBlobSourcePath, input)
+	if handleCreateError(resp, "Azure Blob Storage Source", err) {
+		return
+	}
+	tflog.Debug(ctx, "Created Azure Blob Storage Source", map[string]any{
+		"id": blobSource.IntegrationId,
+	})
+
+	data.Id = types.StringValue(blobSource.IntegrationId)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *azureBlobSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data azureBlobSourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	blobSource, err := client.RestDo[client.AzureBlobSource](ctx, r.rest, http.MethodGet, azureBlobSourcePath+"/"+data.Id.ValueString(), nil)
+	if handleReadError(ctx, resp, "Azure Blob Storage Source", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Got Azure Blob Storage Source", map[string]any{
+		"id": blobSource.IntegrationId,
+	})
+
+	// Map all API response fields to state EXCEPT client_secret, which the API
+	// always returns as "".
+	data.Id = types.StringValue(blobSource.IntegrationId)
+	data.IntegrationLabel = types.StringValue(blobSource.IntegrationLabel)
+	data.TenantId = types.StringValue(blobSource.TenantId)
+	data.ClientId = types.StringValue(blobSource.ClientId)
+	data.StorageAccountName = types.StringValue(blobSource.StorageAccountName)
+	data.ContainerName = types.StringValue(blobSource.ContainerName)
+	data.LogStreamType = types.StringValue(blobSource.LogStreamType)
+
+	if blobSource.LogStreamTypeOptions != nil {
+		attributeTypes := resource_azure_blob_source.LogStreamTypeOptionsValue{}.AttributeTypes(ctx)
+		attributeValues := map[string]attr.Value{
+			"json_array_envelope_field": types.StringValue(blobSource.LogStreamTypeOptions.JsonArrayEnvelopeField),
+			"xml_root_element":          types.StringValue(blobSource.LogStreamTypeOptions.XmlRootElement),
+		}
+		logStreamTypeOptionsValue, diags := resource_azure_blob_source.NewLogStreamTypeOptionsValue(attributeTypes, attributeValues)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+		} else {
+			data.LogStreamTypeOptions = logStreamTypeOptionsValue
+		}
+	}
+
+	data.PrefixLogTypes = azureBlobPrefixLogTypesFromResponse(ctx, blobSource.PrefixLogTypes, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *azureBlobSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data azureBlobSourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := client.AzureBlobSourceInput{
+		IntegrationLabel:     data.IntegrationLabel.ValueString(),
+		TenantId:             data.TenantId.ValueString(),
+		ClientId:             data.ClientId.ValueString(),
+		ClientSecret:         secretValue(ctx, req.Config, "client_secret", data.ClientSecret, &resp.Diagnostics),
+		StorageAccountName:   data.StorageAccountName.ValueString(),
+		ContainerName:        data.ContainerName.ValueString(),
+		LogStreamType:        data.LogStreamType.ValueString(),
+		LogStreamTypeOptions: azureBlobLogStreamTypeOptions(data.LogStreamTypeOptions),
+		PrefixLogTypes:       azureBlobPrefixLogTypesToInput(ctx, data.PrefixLogTypes, &resp.Diagnostics),
+	}
+
+	_, err := client.RestDo[client.AzureBlobSource](ctx, r.rest, http.MethodPut, azureBlobSourcePath+"/"+data.Id.ValueString(), input)
+	if handleUpdateError(ctx, resp, "Azure Blob Storage Source", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated Azure Blob Storage Source", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+
+	// Save plan data to state (not full API response — client_secret would be lost)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *azureBlobSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data azureBlobSourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RestDelete(ctx, r.rest, azureBlobSourcePath+"/"+data.Id.ValueString())
+	if handleDeleteError(resp, "Azure Blob Storage Source", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Deleted Azure Blob Storage Source", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *azureBlobSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func azureBlobLogStreamTypeOptions(opts resource_azure_blob_source.LogStreamTypeOptionsValue) *client.AzureLogStreamTypeOptions {
+	if opts.IsNull() {
+		return nil
+	}
+	return &client.AzureLogStreamTypeOptions{
+		JsonArrayEnvelopeField: opts.JsonArrayEnvelopeField.ValueString(),
+		XmlRootElement:         opts.XmlRootElement.ValueString(),
+	}
+}
+
+// azureBlobPrefixLogTypesToInput converts the Terraform model list to client input structs.
+func azureBlobPrefixLogTypesToInput(ctx context.Context, tfList types.List, diagnostics *diag.Diagnostics) []client.AzurePrefixLogTypesInput {
+	var elements []resource_azure_blob_source.PrefixLogTypesValue
+	diagnostics.Append(tfList.ElementsAs(ctx, &elements, false)...)
+
+	result := make([]client.AzurePrefixLogTypesInput, 0, len(elements))
+	for _, e := range elements {
+		var logTypes []string
+		diagnostics.Append(e.LogTypes.ElementsAs(ctx, &logTypes, false)...)
+
+		var excludedPrefixes []string
+		diagnostics.Append(e.ExcludedPrefixes.ElementsAs(ctx, &excludedPrefixes, false)...)
+
+		result = append(result, client.AzurePrefixLogTypesInput{
+			Prefix:           e.Prefix.ValueString(),
+			LogTypes:         logTypes,
+			ExcludedPrefixes: excludedPrefixes,
+		})
+	}
+	return result
+}
+
+// azureBlobPrefixLogTypesFromResponse converts API response prefix mappings to the Terraform model list.
+func azureBlobPrefixLogTypesFromResponse(ctx context.Context, apiPrefixes []client.AzurePrefixLogTypesInput, diagnostics *diag.Diagnostics) types.List {
+	attrTypes := resource_azure_blob_source.PrefixLogTypesValue{}.AttributeTypes(ctx)
+	elemType := resource_azure_blob_source.PrefixLogTypesType{
+		ObjectType: types.ObjectType{AttrTypes: attrTypes},
+	}
+
+	elements := make([]attr.Value, 0, len(apiPrefixes))
+	for _, p := range apiPrefixes {
+		logTypes, d := types.ListValueFrom(ctx, types.StringType, p.LogTypes)
+		diagnostics.Append(d...)
+
+		excludedPrefixes, d := types.ListValueFrom(ctx, types.StringType, nonNilStrings(p.ExcludedPrefixes))
+		diagnostics.Append(d...)
+
+		val, d := resource_azure_blob_source.NewPrefixLogTypesValue(attrTypes, map[string]attr.Value{
+			"prefix":            types.StringValue(p.Prefix),
+			"log_types":         logTypes,
+			"excluded_prefixes": excludedPrefixes,
+		})
+		diagnostics.Append(d...)
+		elements = append(elements, val)
+	}
+
+	list, d := types.ListValue(elemType, elements)
+	diagnostics.Append(d...)
+	return list
+}
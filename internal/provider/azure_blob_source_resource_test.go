@@ -0,0 +1,164 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const (
+	testAzureTenantID = "72f988bf-86f1-41af-91ab-2d7cd011db47"
+	testAzureClientID = "0b4f2c1e-6d7a-4c3b-9e8f-1a2b3c4d5e6f"
+)
+
+// TestAzureBlobSourceResource covers create, import (the secret can't be read back),
+// an update that remaps prefixes and switches to a JSON array stream, and recreating
+// a source deleted outside Terraform.
+func TestAzureBlobSourceResource(t *testing.T) {
+	label := "test-azure-blob-" + uuid.NewString()
+	container := "logs-" + uuid.NewString()[:8]
+	updated := providerConfig + fmt.Sprintf(`
+resource "panther_azure_blob_source" "test" {
+  integration_label    = %q
+  tenant_id            = %q
+  client_id            = %q
+  client_secret        = "secret-1"
+  storage_account_name = "pantherlogs"
+  container_name       = %q
+  log_stream_type      = "JsonArray"
+  log_stream_type_options = {
+    json_array_envelope_field = "records"
+  }
+  prefix_log_types = [
+    {
+      prefix            = "cloudtrail/"
+      log_types         = ["AWS.CloudTrail"]
+      excluded_prefixes = ["cloudtrail/digest/"]
+    },
+    {
+      prefix    = "vpc/"
+      log_types = ["AWS.VPCFlow"]
+    },
+  ]
+}
+`, label, testAzureTenantID, testAzureClientID, container)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "panther_azure_blob_source" "test" {
+  integration_label    = %q
+  tenant_id            = %q
+  client_id            = %q
+  client_secret        = "secret-1"
+  storage_account_name = "pantherlogs"
+  container_name       = %q
+  log_stream_type      = "Lines"
+  prefix_log_types = [{
+    prefix    = ""
+    log_types = ["AWS.CloudTrail"]
+  }]
+}
+`, label, testAzureTenantID, testAzureClientID, container),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("panther_azure_blob_source.test", "id"),
+					resource.TestCheckResourceAttr("panther_azure_blob_source.test", "client_secret", "secret-1"),
+					resource.TestCheckResourceAttr("panther_azure_blob_source.test", "prefix_log_types.0.excluded_prefixes.#", "0"),
+					resource.TestCheckNoResourceAttr("panther_azure_blob_source.test", "log_stream_type_options"),
+				),
+			},
+			{
+				ResourceName:            "panther_azure_blob_source.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_azure_blob_source.test", "log_stream_type_options.json_array_envelope_field", "records"),
+					resource.TestCheckResourceAttr("panther_azure_blob_source.test", "prefix_log_types.#", "2"),
+					resource.TestCheckResourceAttr("panther_azure_blob_source.test", "prefix_log_types.0.excluded_prefixes.0", "cloudtrail/digest/"),
+					resource.TestCheckResourceAttr("panther_azure_blob_source.test", "prefix_log_types.1.log_types.0", "AWS.VPCFlow"),
+				),
+			},
+			// Delete the source outside Terraform; Read must drop it from state so the
+			// refresh plan recreates it.
+			{
+				Config:             updated,
+				Check:              manuallyDeleteSource(t, "panther_azure_blob_source.test", azureBlobSourcePath),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// TestAzureBlobSourceResource_PlanTimeValidation covers errors raised before any API call.
+func TestAzureBlobSourceResource_PlanTimeValidation(t *testing.T) {
+	cases := map[string]struct {
+		tenantID, storageAccount, container, logType string
+		err                                          string
+	}{
+		"tenant_not_guid": {
+			tenantID: "contoso.onmicrosoft.com", storageAccount: "pantherlogs", container: "logs", logType: "AWS.CloudTrail",
+			err: `must be a tenant ID`,
+		},
+		"storage_account_uppercase": {
+			tenantID: testAzureTenantID, storageAccount: "PantherLogs", container: "logs", logType: "AWS.CloudTrail",
+			err: `must be a storage account\s+name`,
+		},
+		"container_double_hyphen": {
+			tenantID: testAzureTenantID, storageAccount: "pantherlogs", container: "app--logs", logType: "AWS.CloudTrail",
+			err: `must be a container\s+name`,
+		},
+		"unknown_log_type": {
+			tenantID: testAzureTenantID, storageAccount: "pantherlogs", container: "logs", logType: "AWS.Cloudtrail",
+			err: `(?s)Unknown Log Type.*Did you\s+mean\s+"AWS.CloudTrail"`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_azure_blob_source" "test" {
+  integration_label    = "plan-time-validation"
+  tenant_id            = %q
+  client_id            = %q
+  client_secret        = "secret"
+  storage_account_name = %q
+  container_name       = %q
+  log_stream_type      = "Auto"
+  prefix_log_types     = [{ log_types = [%q] }]
+}
+`, tc.tenantID, testAzureClientID, tc.storageAccount, tc.container, tc.logType),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.err),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-panther/internal/client"
+	"terraform-provider-panther/internal/provider/resource_azure_eventhub_source"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                     = (*azureEventHubSourceResource)(nil)
+	_ resource.ResourceWithConfigure        = (*azureEventHubSourceResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*azureEventHubSourceResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*azureEventHubSourceResource)(nil)
+	_ resource.ResourceWithImportState      = (*azureEventHubSourceResource)(nil)
+)
+
+func NewAzureEventHubSourceResource() resource.Resource {
+	return &azureEventHubSourceResource{}
+}
+
+type azureEventHubSourceResource struct {
+	rest *client.RESTClient
+}
+
+// azureEventHubSourceModel is the generated model plus the write-only variants of its
+// secrets. The *Wo fields are only ever set in config; see secretValue.
+type azureEventHubSourceModel struct {
+	resource_azure_eventhub_source.AzureEventhubSourceModel
+	ClientSecretWo        types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWoVersion types.Int64  `tfsdk:"client_secret_wo_version"`
+}
+
+func (r *azureEventHubSourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_azure_eventhub_source"
+}
+
+func (r *azureEventHubSourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_azure_eventhub_source.AzureEventhubSourceResourceSchema(ctx)
+	// Events carry no object key to match prefixes against, so unlike the Blob
+	// Storage source the log types apply to the whole hub.
+	resp.Schema.MarkdownDescription = "Represents an Azure Event Hub Log Source in Panther"
+	applySchemaOverrides(&resp.Schema, append(azureCredentialOverrides(),
+		SchemaOverride{Name: "namespace", Validators: []validator.String{
+			stringvalidator.RegexMatches(azureEventHubNamespaceRegex,
+				"must be an Event Hubs namespace name: 6-50 letters, digits and hyphens, starting with a letter"),
+		}},
+		SchemaOverride{Name: "event_hub_name", Validators: []validator.String{
+			stringvalidator.RegexMatches(azureEventHubNameRegex,
+				"must be an event hub name: up to 256 letters, digits, periods, hyphens and underscores, "+
+					"starting and ending with a letter or digit"),
+		}},
+		SchemaOverride{Name: "consumer_group", Default: stringdefault.StaticString("$Default")},
+	))
+
+	// log_stream_type_options: inner string field defaults + null object default
+	logStreamTypeOptions := resp.Schema.Attributes["log_stream_type_options"].(schema.SingleNestedAttribute)
+
+	jsonArrayEnvelopeField := logStreamTypeOptions.Attributes["json_array_envelope_field"].(schema.StringAttribute)
+	jsonArrayEnvelopeField.Default = stringdefault.StaticString("")
+	logStreamTypeOptions.Attributes["json_array_envelope_field"] = jsonArrayEnvelopeField
+
+	xmlRootElement := logStreamTypeOptions.Attributes["xml_root_element"].(schema.StringAttribute)
+	xmlRootElement.Default = stringdefault.StaticString("")
+	logStreamTypeOptions.Attributes["xml_root_element"] = xmlRootElement
+
+	logStreamTypeOptions.Default = objectdefault.StaticValue(types.ObjectNull(
+		resource_azure_eventhub_source.LogStreamTypeOptionsValue{}.AttributeTypes(ctx),
+	))
+
+	resp.Schema.Attributes["log_stream_type_options"] = logStreamTypeOptions
+}
+
+func (r *azureEventHubSourceResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{logStreamTypeOptionsValidator{}}
+}
+
+func (r *azureEventHubSourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateLogTypes(ctx, r.rest, req, resp, logTypesExpression)
+}
+
+func (r *azureEventHubSourceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+func (r *azureEventHubSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data azureEventHubSourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := client.AzureEventHubSourceInput{
+		IntegrationLabel:     data.IntegrationLabel.ValueString(),
+		TenantId:             data.TenantId.ValueString(),
+		ClientId:             data.ClientId.ValueString(),
+		ClientSecret:         secretValue(ctx, req.Config, "client_secret", data.ClientSecret, &resp.Diagnostics),
+		Namespace:            data.Namespace.ValueString(),
+		EventHubName:         data.EventHubName.ValueString(),
+		ConsumerGroup:        data.ConsumerGroup.ValueString(),
+		LogTypes:             listToStringSlice(ctx, data.LogTypes, &resp.Diagnostics),
+		LogStreamType:        data.LogStreamType.ValueString(),
+		LogStreamTypeOptions: azureEventHubLogStreamTypeOptions(data.LogStreamTypeOptions),
+	}
+
+	eventHubSource, err := client.RestDo[client.AzureEventHubSource](ctx, r.rest, http.MethodPost, azureEventHubSourcePath, input)
+	if handleCreateError(resp, "Azure Event Hub Source", err) {
+		return
+	}
+	tflog.Debug(ctx, "Created Azure Event Hub Source", map[string]any{
+		"id": eventHubSource.IntegrationId,
+	})
+
+	data.Id = types.StringValue(eventHubSource.IntegrationId)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *azureEventHubSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data azureEventHubSourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eventHubSource, err := client.RestDo[client.AzureEventHubSource](ctx, r.rest, http.MethodGet, azureEventHubSourcePath+"/"+data.Id.ValueString(), nil)
+	if handleReadError(ctx, resp, "Azure Event Hub Source", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Got Azure Event Hub Source", map[string]any{
+		"id": eventHubSource.IntegrationId,
+	})
+
+	// Map all API response fields to state EXCEPT client_secret, which the API
+	// always returns as "".
+	data.Id = types.StringValue(eventHubSource.IntegrationId)
+	data.IntegrationLabel = types.StringValue(eventHubSource.IntegrationLabel)
+	data.TenantId = types.StringValue(eventHubSource.TenantId)
+	data.ClientId = types.StringValue(eventHubSource.ClientId)
+	data.Namespace = types.StringValue(eventHubSource.Namespace)
+	data.EventHubName = types.StringValue(eventHubSource.EventHubName)
+	data.ConsumerGroup = types.StringValue(eventHubSource.ConsumerGroup)
+	data.LogTypes = stringSliceToList(ctx, eventHubSource.LogTypes, &resp.Diagnostics)
+	data.LogStreamType = types.StringValue(eventHubSource.LogStreamType)
+
+	if eventHubSource.LogStreamTypeOptions != nil {
+		attributeTypes := resource_azure_eventhub_source.LogStreamTypeOptionsValue{}.AttributeTypes(ctx)
+		attributeValues := map[string]attr.Value{
+			"json_array_envelope_field": types.StringValue(eventHubSource.LogStreamTypeOptions.JsonArrayEnvelopeField),
+			"xml_root_element":          types.StringValue(eventHubSource.LogStreamTypeOptions.XmlRootElement),
+		}
+		logStreamTypeOptionsValue, diags := resource_azure_eventhub_source.NewLogStreamTypeOptionsValue(attributeTypes, attributeValues)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+		} else {
+			data.LogStreamTypeOptions = logStreamTypeOptionsValue
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *azureEventHubSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data azureEventHubSourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := client.AzureEventHubSourceInput{
+		IntegrationLabel:     data.IntegrationLabel.ValueString(),
+		TenantId:             data.TenantId.ValueString(),
+		ClientId:             data.ClientId.ValueString(),
+		ClientSecret:         secretValue(ctx, req.Config, "client_secret", data.ClientSecret, &resp.Diagnostics),
+		Namespace:            data.Namespace.ValueString(),
+		EventHubName:         data.EventHubName.ValueString(),
+		ConsumerGroup:        data.ConsumerGroup.ValueString(),
+		LogTypes:             listToStringSlice(ctx, data.LogTypes, &resp.Diagnostics),
+		LogStreamType:        data.LogStreamType.ValueString(),
+		LogStreamTypeOptions: azureEventHubLogStreamTypeOptions(data.LogStreamTypeOptions),
+	}
+
+	_, err := client.RestDo[client.AzureEventHubSource](ctx, r.rest, http.MethodPut, azureEventHubSourcePath+"/"+data.Id.ValueString(), input)
+	if handleUpdateError(ctx, resp, "Azure Event Hub Source", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated Azure Event Hub Source", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+
+	// Save plan data to state (not full API response — client_secret would be lost)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *azureEventHubSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data azureEventHubSourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RestDelete(ctx, r.rest, azureEventHubSourcePath+"/"+data.Id.ValueString())
+	if handleDeleteError(resp, "Azure Event Hub Source", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Deleted Azure Event Hub Source", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *azureEventHubSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func azureEventHubLogStreamTypeOptions(opts resource_azure_eventhub_source.LogStreamTypeOptionsValue) *client.AzureLogStreamTypeOptions {
+	if opts.IsNull() {
+		return nil
+	}
+	return &client.AzureLogStreamTypeOptions{
+		JsonArrayEnvelopeField: opts.JsonArrayEnvelopeField.ValueString(),
+		XmlRootElement:         opts.XmlRootElement.ValueString(),
+	}
+}
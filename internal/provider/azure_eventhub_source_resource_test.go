@@ -0,0 +1,128 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestAzureEventHubSourceResource creates a source from client_secret_wo, imports it,
+// then moves it to its own consumer group and rotates the secret by bumping
+// client_secret_wo_version. The secret must never reach state.
+func TestAzureEventHubSourceResource(t *testing.T) {
+	label := "test-azure-eventhub-" + uuid.NewString()
+	eventHub := "okta-" + uuid.NewString()[:8]
+	config := func(consumerGroup string, version int) string {
+		return providerConfig + fmt.Sprintf(`
+resource "panther_azure_eventhub_source" "test" {
+  integration_label        = %q
+  tenant_id                = %q
+  client_id                = %q
+  client_secret_wo         = "secret-%d"
+  client_secret_wo_version = %d
+  namespace                = "panther-logs"
+  event_hub_name           = %q
+  log_stream_type          = "JSON"
+  log_types                = ["Okta.SystemLog"]
+  %s
+}
+`, label, testAzureTenantID, testAzureClientID, version, version, eventHub, consumerGroup)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("panther_azure_eventhub_source.test", "id"),
+					resource.TestCheckResourceAttr("panther_azure_eventhub_source.test", "consumer_group", "$Default"),
+					resource.TestCheckResourceAttr("panther_azure_eventhub_source.test", "client_secret", ""),
+					resource.TestCheckNoResourceAttr("panther_azure_eventhub_source.test", "client_secret_wo"),
+				),
+			},
+			{
+				ResourceName:            "panther_azure_eventhub_source.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_secret", "client_secret_wo_version"},
+			},
+			{
+				Config: config(`consumer_group = "panther"`, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_azure_eventhub_source.test", "consumer_group", "panther"),
+					resource.TestCheckResourceAttr("panther_azure_eventhub_source.test", "client_secret_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("panther_azure_eventhub_source.test", "client_secret_wo"),
+				),
+			},
+		},
+	})
+}
+
+// TestAzureEventHubSourceResource_PlanTimeValidation covers errors raised before any API call.
+func TestAzureEventHubSourceResource_PlanTimeValidation(t *testing.T) {
+	cases := map[string]struct {
+		namespace, clientID, logType string
+		err                          string
+	}{
+		"namespace_fqdn": {
+			namespace: "panther-logs.servicebus.windows.net", clientID: testAzureClientID, logType: "Okta.SystemLog",
+			err: `must be an Event Hubs\s+namespace\s+name`,
+		},
+		"client_id_not_guid": {
+			namespace: "panther-logs", clientID: "panther", logType: "Okta.SystemLog",
+			err: `must be an application\s+\(client\)\s+ID`,
+		},
+		"unknown_log_type": {
+			namespace: "panther-logs", clientID: testAzureClientID, logType: "Okta.Systemlog",
+			err: `(?s)Unknown Log Type.*Did you\s+mean\s+"Okta.SystemLog"`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_azure_eventhub_source" "test" {
+  integration_label = "plan-time-validation"
+  tenant_id         = %q
+  client_id         = %q
+  client_secret     = "secret"
+  namespace         = %q
+  event_hub_name    = "okta"
+  log_stream_type   = "JSON"
+  log_types         = [%q]
+}
+`, testAzureTenantID, tc.clientID, tc.namespace, tc.logType),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.err),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"terraform-provider-panther/internal/client"
+	"terraform-provider-panther/internal/provider/resource_cloudwatch_source"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const cloudWatchSourcePath = "/log-sources/cloudwatch"
+
+// logGroupNameRegex follows CloudWatch Logs' own naming rules.
+var logGroupNameRegex = regexp.MustCompile(`^[.\-_/#A-Za-z0-9]{1,512}$`)
+
+var (
+	_ resource.Resource                = (*cloudWatchSourceResource)(nil)
+	_ resource.ResourceWithConfigure   = (*cloudWatchSourceResource)(nil)
+	_ resource.ResourceWithImportState = (*cloudWatchSourceResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*cloudWatchSourceResource)(nil)
+)
+
+func NewCloudWatchSourceResource() resource.Resource {
+	return &cloudWatchSourceResource{}
+}
+
+type cloudWatchSourceResource struct {
+	rest *client.RESTClient
+}
+
+func (r *cloudWatchSourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudwatch_source"
+}
+
+func (r *cloudWatchSourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_cloudwatch_source.CloudwatchSourceResourceSchema(ctx)
+	resp.Schema.MarkdownDescription = "Represents a CloudWatch Logs Log Source in Panther. Events from the log group reach " +
+		"Panther through a subscription filter, which Panther manages unless `managed_subscription` is false."
+	// The API can't move a source to another log group; a different group is a new source.
+	applySchemaOverrides(&resp.Schema, []SchemaOverride{
+		{Name: "id", PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+		{
+			Name:          "aws_account_id",
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators:    []validator.String{awsAccountIDValidator()},
+		},
+		{
+			Name:          "region",
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators:    []validator.String{awsRegionValidator()},
+		},
+		{
+			Name:          "log_group_name",
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators: []validator.String{stringvalidator.RegexMatches(logGroupNameRegex,
+				"must be a log group name: up to 512 letters, digits and . - _ / #")},
+		},
+		{
+			Name:       "filter_pattern",
+			Default:    stringdefault.StaticString(""),
+			Validators: []validator.String{stringvalidator.LengthAtMost(1024)},
+		},
+		{Name: "log_processing_role", Validators: []validator.String{iamRoleARNValidator("PantherLogProcessingRole")}},
+	})
+
+	managed := resp.Schema.Attributes["managed_subscription"].(schema.BoolAttribute)
+	managed.Default = booldefault.StaticBool(true)
+	resp.Schema.Attributes["managed_subscription"] = managed
+}
+
+func (r *cloudWatchSourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateLogTypes(ctx, r.rest, req, resp, logTypesExpression)
+}
+
+func (r *cloudWatchSourceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+func (r *cloudWatchSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_cloudwatch_source.CloudwatchSourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := cloudWatchSourceInput(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudWatchSource, err := client.RestDo[client.CloudWatchSource](ctx, r.rest, http.MethodPost, cloudWatchSourcePath, input)
+	if handleCreateError(resp, "CloudWatch Logs Source", err) {
+		return
+	}
+	tflog.Debug(ctx, "Created CloudWatch Logs Source", map[string]any{
+		"id": cloudWatchSource.IntegrationId,
+	})
+
+	setCloudWatchSource(ctx, &data, cloudWatchSource, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudWatchSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_cloudwatch_source.CloudwatchSourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudWatchSource, err := client.RestDo[client.CloudWatchSource](ctx, r.rest, http.MethodGet, cloudWatchSourcePath+"/"+data.Id.ValueString(), nil)
+	if handleReadError(ctx, resp, "CloudWatch Logs Source", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Got CloudWatch Logs Source", map[string]any{
+		"id": cloudWatchSource.IntegrationId,
+	})
+
+	setCloudWatchSource(ctx, &data, cloudWatchSource, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudWatchSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_cloudwatch_source.CloudwatchSourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := cloudWatchSourceInput(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudWatchSource, err := client.RestDo[client.CloudWatchSource](ctx, r.rest, http.MethodPut, cloudWatchSourcePath+"/"+data.Id.ValueString(), input)
+	if handleUpdateError(ctx, resp, "CloudWatch Logs Source", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated CloudWatch Logs Source", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+
+	setCloudWatchSource(ctx, &data, cloudWatchSource, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *cloudWatchSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_cloudwatch_source.CloudwatchSourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RestDelete(ctx, r.rest, cloudWatchSourcePath+"/"+data.Id.ValueString())
+	if handleDeleteError(resp, "CloudWatch Logs Source", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Deleted CloudWatch Logs Source", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *cloudWatchSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func cloudWatchSourceInput(ctx context.Context, data resource_cloudwatch_source.CloudwatchSourceModel, diagnostics *diag.Diagnostics) client.CloudWatchSourceInput {
+	return client.CloudWatchSourceInput{
+		IntegrationLabel:    data.IntegrationLabel.ValueString(),
+		AwsAccountId:        data.AwsAccountId.ValueString(),
+		Region:              data.Region.ValueString(),
+		LogGroupName:        data.LogGroupName.ValueString(),
+		FilterPattern:       data.FilterPattern.ValueString(),
+		ManagedSubscription: data.ManagedSubscription.ValueBool(),
+		LogProcessingRole:   data.LogProcessingRole.ValueString(),
+		LogTypes:            listToStringSlice(ctx, data.LogTypes, diagnostics),
+	}
+}
+
+// setCloudWatchSource maps the API response to state. The source has no secrets, so
+// every field comes from the API.
+func setCloudWatchSource(ctx context.Context, data *resource_cloudwatch_source.CloudwatchSourceModel, cloudWatchSource client.CloudWatchSource, diagnostics *diag.Diagnostics) {
+	data.Id = types.StringValue(cloudWatchSource.IntegrationId)
+	data.IntegrationLabel = types.StringValue(cloudWatchSource.IntegrationLabel)
+	data.AwsAccountId = types.StringValue(cloudWatchSource.AwsAccountId)
+	data.Region = types.StringValue(cloudWatchSource.Region)
+	data.LogGroupName = types.StringValue(cloudWatchSource.LogGroupName)
+	data.FilterPattern = types.StringValue(cloudWatchSource.FilterPattern)
+	data.ManagedSubscription = types.BoolValue(cloudWatchSource.ManagedSubscription)
+	data.LogProcessingRole = types.StringValue(cloudWatchSource.LogProcessingRole)
+	data.LogTypes = stringSliceToList(ctx, cloudWatchSource.LogTypes, diagnostics)
+}
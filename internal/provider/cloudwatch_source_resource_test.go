@@ -0,0 +1,141 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestCloudWatchSourceResource covers create with the default filter pattern and a managed
+// subscription, import, an in-place update, and replacement when the log group changes.
+func TestCloudWatchSourceResource(t *testing.T) {
+	label := "test-cloudwatch-" + uuid.NewString()
+	config := func(logGroup, extra string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "panther_cloudwatch_source" "test" {
+  integration_label   = %q
+  aws_account_id      = "123456789012"
+  region              = "us-east-1"
+  log_group_name      = %q
+  log_processing_role = "arn:aws:iam::123456789012:role/PantherLogProcessingRole"
+  log_types           = ["AWS.VPCFlow"]
+  %s
+}
+`, label, logGroup, extra)
+	}
+	var originalID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("/aws/vpc/flow-logs", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_cloudwatch_source.test", "filter_pattern", ""),
+					resource.TestCheckResourceAttr("panther_cloudwatch_source.test", "managed_subscription", "true"),
+					resource.TestCheckResourceAttrWith("panther_cloudwatch_source.test", "id", func(v string) error {
+						originalID = v
+						if v == "" {
+							return fmt.Errorf("id is empty")
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:      "panther_cloudwatch_source.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config("/aws/vpc/flow-logs", `
+  filter_pattern       = "[version, account, eni, source, destination, srcport, destport, protocol, packets, bytes, windowstart, windowend, action=\"REJECT\", flowlogstatus]"
+  managed_subscription = false`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_cloudwatch_source.test", "managed_subscription", "false"),
+					resource.TestMatchResourceAttr("panther_cloudwatch_source.test", "filter_pattern", regexp.MustCompile(`action="REJECT"`)),
+					resource.TestCheckResourceAttrPtr("panther_cloudwatch_source.test", "id", &originalID),
+				),
+			},
+			{
+				Config: config("/aws/vpc/flow-logs-v2", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_cloudwatch_source.test", "log_group_name", "/aws/vpc/flow-logs-v2"),
+					resource.TestCheckResourceAttrWith("panther_cloudwatch_source.test", "id", func(v string) error {
+						if v == originalID {
+							return fmt.Errorf("id did not change after log_group_name replacement: %s", v)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+// TestCloudWatchSourceResource_PlanTimeValidation covers errors raised before any API call.
+func TestCloudWatchSourceResource_PlanTimeValidation(t *testing.T) {
+	cases := map[string]struct {
+		region, logGroup, role, logType string
+		err                             string
+	}{
+		"region_name": {
+			region: "N. Virginia", logGroup: "/aws/lambda/app", role: "arn:aws:iam::123456789012:role/PantherLogProcessingRole",
+			logType: "AWS.VPCFlow", err: `valid AWS region\s+code`,
+		},
+		"log_group_arn": {
+			region: "us-east-1", logGroup: "arn:aws:logs:us-east-1:123456789012:log-group:/aws/lambda/app",
+			role: "arn:aws:iam::123456789012:role/PantherLogProcessingRole", logType: "AWS.VPCFlow",
+			err: `must be a log group\s+name`,
+		},
+		"role_not_arn": {
+			region: "us-east-1", logGroup: "/aws/lambda/app", role: "PantherLogProcessingRole",
+			logType: "AWS.VPCFlow", err: `valid IAM role\s+ARN`,
+		},
+		"unknown_log_type": {
+			region: "us-east-1", logGroup: "/aws/lambda/app", role: "arn:aws:iam::123456789012:role/PantherLogProcessingRole",
+			logType: "AWS.VPCflow", err: `(?s)Unknown Log Type.*Did you\s+mean\s+"AWS.VPCFlow"`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_cloudwatch_source" "test" {
+  integration_label   = "plan-time-validation"
+  aws_account_id      = "123456789012"
+  region              = %q
+  log_group_name      = %q
+  log_processing_role = %q
+  log_types           = [%q]
+}
+`, tc.region, tc.logGroup, tc.role, tc.logType),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.err),
+					},
+				},
+			})
+		})
+	}
+}
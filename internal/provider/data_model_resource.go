@@ -0,0 +1,328 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const dataModelPath = "/data-models"
+
+var dataModelMappingAttrTypes = map[string]attr.Type{
+	"name":   types.StringType,
+	"path":   types.StringType,
+	"method": types.StringType,
+}
+
+var (
+	_ resource.Resource                   = (*dataModelResource)(nil)
+	_ resource.ResourceWithConfigure      = (*dataModelResource)(nil)
+	_ resource.ResourceWithImportState    = (*dataModelResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*dataModelResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*dataModelResource)(nil)
+)
+
+func NewDataModelResource() resource.Resource {
+	return &dataModelResource{}
+}
+
+type dataModelResource struct {
+	rest *client.RESTClient
+}
+
+type dataModelModel struct {
+	Id          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	LogType     types.String `tfsdk:"log_type"`
+	Mappings    types.List   `tfsdk:"mappings"`
+	Body        types.String `tfsdk:"body"`
+}
+
+type dataModelMappingModel struct {
+	Name   types.String `tfsdk:"name"`
+	Path   types.String `tfsdk:"path"`
+	Method types.String `tfsdk:"method"`
+}
+
+func (r *dataModelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_model"
+}
+
+func (r *dataModelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Panther data model: unified field names for one log type, which detections " +
+			"read with `event.udm(name)`. A log type can have only one enabled data model.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The data model ID (e.g. `AWS.CloudTrail`). Changing this forces a new data model.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"display_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "The name shown in the Panther console. Defaults to the ID when empty.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether detections see the mappings. Defaults to true.",
+			},
+			"log_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The log type the mappings apply to. Checked against the instance's schemas at plan time.",
+			},
+			"mappings": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The unified fields, each mapped to a field of the log type by path or computed by a method.",
+				Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The unified field name detections pass to `event.udm()`. Unique within the data model.",
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"path": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The JSON path of the field in the log type (e.g. `$.sourceIPAddress`). Exactly one of `path` and `method` must be set.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("method")),
+							},
+						},
+						"method": schema.StringAttribute{
+							Optional: true,
+							MarkdownDescription: "The name of a function in `body` that takes the event and returns the " +
+								"field's value. Exactly one of `path` and `method` must be set.",
+							Validators: []validator.String{stringvalidator.RegexMatches(pythonIdentifierRegex,
+								"must be a valid Python function name")},
+						},
+					},
+				},
+			},
+			"body": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The Python source defining the functions named by `method` mappings.",
+			},
+		},
+	}
+}
+
+func (r *dataModelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+// ValidateConfig rejects duplicate mapping names and methods the body doesn't define,
+// which the API would otherwise only report at apply.
+func (r *dataModelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data dataModelModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Mappings.IsUnknown() {
+		return
+	}
+	var mappings []dataModelMappingModel
+	resp.Diagnostics.Append(data.Mappings.ElementsAs(ctx, &mappings, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for i, mapping := range mappings {
+		name := mapping.Name.ValueString()
+		if !mapping.Name.IsUnknown() && seen[name] {
+			resp.Diagnostics.AddAttributeError(path.Root("mappings").AtListIndex(i).AtName("name"),
+				"Duplicate Mapping", fmt.Sprintf("The data model already maps %q.", name))
+		}
+		seen[name] = true
+
+		if mapping.Method.IsNull() || mapping.Method.IsUnknown() || data.Body.IsUnknown() {
+			continue
+		}
+		method := mapping.Method.ValueString()
+		if !pythonFunctionDefined(data.Body.ValueString(), method) {
+			resp.Diagnostics.AddAttributeError(path.Root("mappings").AtListIndex(i).AtName("method"),
+				"Undefined Mapping Method", fmt.Sprintf("body does not define a function named %q (def %s(event): ...).", method, method))
+		}
+	}
+}
+
+func pythonFunctionDefined(body, name string) bool {
+	return regexp.MustCompile(`(?m)^def\s+` + regexp.QuoteMeta(name) + `\s*\(`).MatchString(body)
+}
+
+func (r *dataModelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateLogTypes(ctx, r.rest, req, resp, path.MatchRoot("log_type"))
+}
+
+func (r *dataModelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data dataModelModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := data.toAPI(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model, err := client.RestDo[client.DataModel](ctx, r.rest, http.MethodPost, dataModelPath, input)
+	if handleCreateError(resp, "Data Model", err) {
+		return
+	}
+	tflog.Debug(ctx, "Created Data Model", map[string]any{"id": model.ID})
+
+	data.set(ctx, model, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dataModelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dataModelModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model, err := client.RestDo[client.DataModel](ctx, r.rest, http.MethodGet, dataModelPath+"/"+data.Id.ValueString(), nil)
+	if handleReadError(ctx, resp, "Data Model", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Read Data Model", map[string]any{"id": model.ID})
+
+	data.set(ctx, model, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dataModelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data dataModelModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := data.toAPI(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model, err := client.RestDo[client.DataModel](ctx, r.rest, http.MethodPut, dataModelPath+"/"+data.Id.ValueString(), input)
+	if handleUpdateError(ctx, resp, "Data Model", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated Data Model", map[string]any{"id": data.Id.ValueString()})
+
+	data.set(ctx, model, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dataModelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data dataModelModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RestDelete(ctx, r.rest, dataModelPath+"/"+data.Id.ValueString())
+	if handleDeleteError(resp, "Data Model", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Deleted Data Model", map[string]any{"id": data.Id.ValueString()})
+}
+
+func (r *dataModelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m dataModelModel) toAPI(ctx context.Context, diagnostics *diag.Diagnostics) client.DataModel {
+	model := client.DataModel{
+		ID:          m.Id.ValueString(),
+		DisplayName: m.DisplayName.ValueString(),
+		Enabled:     m.Enabled.ValueBool(),
+		LogTypes:    []string{m.LogType.ValueString()},
+		Mappings:    []client.DataModelMapping{},
+		Body:        m.Body.ValueString(),
+	}
+	var mappings []dataModelMappingModel
+	diagnostics.Append(m.Mappings.ElementsAs(ctx, &mappings, false)...)
+	for _, mapping := range mappings {
+		model.Mappings = append(model.Mappings, client.DataModelMapping{
+			Name:   mapping.Name.ValueString(),
+			Path:   mapping.Path.ValueString(),
+			Method: mapping.Method.ValueString(),
+		})
+	}
+	return model
+}
+
+// set copies an API response into the model. The API omits whichever of path and
+// method a mapping doesn't use; both read back as null, as configured.
+func (m *dataModelModel) set(ctx context.Context, model client.DataModel, diagnostics *diag.Diagnostics) {
+	m.Id = types.StringValue(model.ID)
+	m.DisplayName = types.StringValue(model.DisplayName)
+	m.Enabled = types.BoolValue(model.Enabled)
+	m.LogType = types.StringNull()
+	if len(model.LogTypes) > 0 {
+		m.LogType = types.StringValue(model.LogTypes[0])
+	}
+	m.Body = types.StringValue(model.Body)
+
+	mappings := make([]dataModelMappingModel, 0, len(model.Mappings))
+	for _, mapping := range model.Mappings {
+		mappings = append(mappings, dataModelMappingModel{
+			Name:   types.StringValue(mapping.Name),
+			Path:   optionalString(mapping.Path),
+			Method: optionalString(mapping.Method),
+		})
+	}
+	var d diag.Diagnostics
+	m.Mappings, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dataModelMappingAttrTypes}, mappings)
+	diagnostics.Append(d...)
+}
+
+// optionalString maps the API's empty string to null, for optional attributes without
+// a default.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
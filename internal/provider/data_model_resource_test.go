@@ -0,0 +1,180 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDataModelSpec = "fields:\n  - name: src\n    type: string\n  - name: user\n    type: string\n"
+
+// TestDataModelResource covers create with path and method mappings, import, and an
+// update replacing a method mapping with a path. The data model is bound to its own
+// custom schema so it can't clash with another data model for the same log type.
+func TestDataModelResource(t *testing.T) {
+	suffix := strings.ReplaceAll(uuid.NewString(), "-", "")
+	logType := "Custom.DataModelTest" + suffix
+	config := func(mappings, body string) string {
+		return providerConfig + testSchemaResourceConfig(logType, "", testDataModelSpec) + fmt.Sprintf(`
+resource "panther_data_model" "test" {
+  id       = "Test.DataModel.%s"
+  log_type = panther_schema.test.name
+  mappings = [%s]
+  body     = %q
+}
+`, suffix, mappings, body)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+    { name = "source_ip", path = "$.src" },
+    { name = "actor_user", method = "get_user" },
+  `, "def get_user(event):\n    return event.get('user')\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_data_model.test", "log_type", logType),
+					resource.TestCheckResourceAttr("panther_data_model.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("panther_data_model.test", "mappings.#", "2"),
+					resource.TestCheckResourceAttr("panther_data_model.test", "mappings.0.path", "$.src"),
+					resource.TestCheckNoResourceAttr("panther_data_model.test", "mappings.0.method"),
+					resource.TestCheckResourceAttr("panther_data_model.test", "mappings.1.method", "get_user"),
+					resource.TestCheckNoResourceAttr("panther_data_model.test", "mappings.1.path"),
+				),
+			},
+			{
+				ResourceName:      "panther_data_model.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(`
+    { name = "source_ip", path = "$.src" },
+    { name = "actor_user", path = "$.user" },
+  `, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_data_model.test", "mappings.1.path", "$.user"),
+					resource.TestCheckNoResourceAttr("panther_data_model.test", "mappings.1.method"),
+					resource.TestCheckResourceAttr("panther_data_model.test", "body", ""),
+				),
+			},
+		},
+	})
+}
+
+// TestDataModelResource_OneEnabledPerLogType checks that the API's conflict for a second
+// enabled data model surfaces as the shared "already exists" error, and that a disabled
+// one is accepted.
+func TestDataModelResource_OneEnabledPerLogType(t *testing.T) {
+	suffix := strings.ReplaceAll(uuid.NewString(), "-", "")
+	config := func(enabled bool) string {
+		return providerConfig + testSchemaResourceConfig("Custom.DataModelConflict"+suffix, "", testDataModelSpec) + fmt.Sprintf(`
+resource "panther_data_model" "first" {
+  id       = "Test.DataModel.First.%[1]s"
+  log_type = panther_schema.test.name
+  mappings = [{ name = "source_ip", path = "$.src" }]
+}
+
+resource "panther_data_model" "second" {
+  id       = "Test.DataModel.Second.%[1]s"
+  log_type = panther_schema.test.name
+  enabled  = %[2]t
+  mappings = [{ name = "source_ip", path = "$.src" }]
+
+  depends_on = [panther_data_model.first]
+}
+`, suffix, enabled)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(true),
+				ExpectError: regexp.MustCompile(`Data Model already exists`),
+			},
+			{
+				Config: config(false),
+				Check:  resource.TestCheckResourceAttr("panther_data_model.second", "enabled", "false"),
+			},
+		},
+	})
+}
+
+// TestDataModelResource_PlanTimeValidation covers errors raised before any API call.
+func TestDataModelResource_PlanTimeValidation(t *testing.T) {
+	cases := map[string]struct {
+		logType  string
+		mappings string
+		body     string
+		err      string
+	}{
+		"path_and_method": {
+			logType:  "AWS.CloudTrail",
+			mappings: `{ name = "user", path = "$.user", method = "get_user" }`,
+			body:     "def get_user(event):\n    return None\n",
+			err:      `(?s)Invalid Attribute Combination.*2 attributes specified`,
+		},
+		"neither_path_nor_method": {
+			logType:  "AWS.CloudTrail",
+			mappings: `{ name = "user" }`,
+			err:      `Invalid Attribute Combination`,
+		},
+		"undefined_method": {
+			logType:  "AWS.CloudTrail",
+			mappings: `{ name = "user", method = "get_user" }`,
+			body:     "def get_username(event):\n    return None\n",
+			err:      `(?s)Undefined Mapping Method.*"get_user"`,
+		},
+		"duplicate_name": {
+			logType:  "AWS.CloudTrail",
+			mappings: `{ name = "user", path = "$.user" }, { name = "user", path = "$.userName" }`,
+			err:      `(?s)Duplicate Mapping.*"user"`,
+		},
+		"unknown_log_type": {
+			logType:  "AWS.Cloudtrail",
+			mappings: `{ name = "user", path = "$.user" }`,
+			err:      `(?s)Unknown Log Type.*Did you\s+mean\s+"AWS.CloudTrail"`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_data_model" "test" {
+  id       = "Test.DataModel.PlanTime"
+  log_type = %q
+  mappings = [%s]
+  body     = %q
+}
+`, tc.logType, tc.mappings, tc.body),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.err),
+					},
+				},
+			})
+		})
+	}
+}
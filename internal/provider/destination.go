@@ -0,0 +1,275 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Alert destinations (panther_destination_*) are hand-written resources over endpoints
+// that share the alert filters. destinationResource implements them all; each kind's
+// file holds its schema, model and API conversion, and this file the shared attributes.
+
+const destinationPath = "/destinations"
+
+var (
+	_ resource.Resource                     = (*destinationResource[slackDestinationModel, client.SlackDestination, *slackDestinationModel])(nil)
+	_ resource.ResourceWithConfigure        = (*destinationResource[slackDestinationModel, client.SlackDestination, *slackDestinationModel])(nil)
+	_ resource.ResourceWithImportState      = (*destinationResource[slackDestinationModel, client.SlackDestination, *slackDestinationModel])(nil)
+	_ resource.ResourceWithModifyPlan       = (*destinationResource[slackDestinationModel, client.SlackDestination, *slackDestinationModel])(nil)
+	_ resource.ResourceWithConfigValidators = (*destinationResource[slackDestinationModel, client.SlackDestination, *slackDestinationModel])(nil)
+)
+
+// destinationAlertTypes are the kinds of alert a destination can receive. Log source
+// alarms (panther_log_source_alarm) raise SYSTEM_ERROR alerts.
+var destinationAlertTypes = []string{"RULE", "RULE_ERROR", "SCHEDULED_RULE", "SCHEDULED_RULE_ERROR", "POLICY", "SYSTEM_ERROR"}
+
+// destinationModelPtr is what destinationResource needs from a kind's model M, a struct
+// embedding destinationModel, converted to and from the API type S.
+type destinationModelPtr[M, S any] interface {
+	*M
+	core() *destinationModel
+	toAPI(ctx context.Context, config tfsdk.Config, diagnostics *diag.Diagnostics) S
+	// set copies an API response into the model. The secret, if any, is write-only in
+	// the API and keeps its configured value.
+	set(ctx context.Context, destination S, diagnostics *diag.Diagnostics)
+}
+
+// destinationResource manages one destination kind: name is used in diagnostics and
+// logs (e.g. "Slack Destination"), path is the collection endpoint and secret the
+// attribute that gets a write-only variant, or "" for a kind without one.
+type destinationResource[M, S any, P destinationModelPtr[M, S]] struct {
+	rest     *client.RESTClient
+	typeName string
+	name     string
+	path     string
+	secret   string
+	schema   func() schema.Schema
+}
+
+func (r *destinationResource[M, S, P]) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+func (r *destinationResource[M, S, P]) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.schema()
+	if r.secret != "" {
+		applySchemaOverrides(&resp.Schema, []SchemaOverride{{Name: r.secret, WriteOnly: true}})
+	}
+}
+
+// ConfigValidators requires the secret or its write-only variant, so a missing one
+// fails at plan time rather than with a 400 from create.
+func (r *destinationResource[M, S, P]) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	if r.secret == "" {
+		return nil
+	}
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(path.MatchRoot(r.secret), path.MatchRoot(r.secret+"_wo")),
+	}
+}
+
+func (r *destinationResource[M, S, P]) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+func (r *destinationResource[M, S, P]) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateLogTypes(ctx, r.rest, req, resp, logTypesExpression)
+}
+
+func (r *destinationResource[M, S, P]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data M
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := P(&data).toAPI(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	destination, err := client.RestDo[S](ctx, r.rest, http.MethodPost, r.path, input)
+	if handleCreateError(resp, r.name, err) {
+		return
+	}
+	P(&data).set(ctx, destination, &resp.Diagnostics)
+	tflog.Debug(ctx, "Created "+r.name, map[string]any{"id": P(&data).core().Id.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *destinationResource[M, S, P]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data M
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := P(&data).core().Id.ValueString()
+	destination, err := client.RestDo[S](ctx, r.rest, http.MethodGet, r.path+"/"+id, nil)
+	if handleReadError(ctx, resp, r.name, id, err) {
+		return
+	}
+	tflog.Debug(ctx, "Read "+r.name, map[string]any{"id": id})
+
+	P(&data).set(ctx, destination, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *destinationResource[M, S, P]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data M
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := P(&data).toAPI(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := P(&data).core().Id.ValueString()
+	destination, err := client.RestDo[S](ctx, r.rest, http.MethodPut, r.path+"/"+id, input)
+	if handleUpdateError(ctx, resp, r.name, id, err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated "+r.name, map[string]any{"id": id})
+
+	P(&data).set(ctx, destination, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *destinationResource[M, S, P]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data M
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := P(&data).core().Id.ValueString()
+	err := client.RestDelete(ctx, r.rest, r.path+"/"+id)
+	if handleDeleteError(resp, r.name, id, err) {
+		return
+	}
+	tflog.Debug(ctx, "Deleted "+r.name, map[string]any{"id": id})
+}
+
+func (r *destinationResource[M, S, P]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// destinationModel holds the attributes every destination has; each resource's model
+// embeds it.
+type destinationModel struct {
+	Id          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Severities  types.List   `tfsdk:"severities"`
+	AlertTypes  types.List   `tfsdk:"alert_types"`
+	LogTypes    types.List   `tfsdk:"log_types"`
+}
+
+// destinationAttributes returns the schema attributes every destination has.
+func destinationAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The destination ID. Use it in a detection's `destination_ids` to route that detection's alerts here.",
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"display_name": schema.StringAttribute{
+			Required:    true,
+			Description: "The name shown in the Panther console.",
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"severities": allOfListAttribute(detectionSeverities,
+			"Only alerts of these severities are sent. Defaults to every severity."),
+		"alert_types": allOfListAttribute(destinationAlertTypes,
+			"Only alerts of these types are sent. `SYSTEM_ERROR` covers log source alarms. Defaults to every type."),
+		"log_types": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			MarkdownDescription: "Only alerts from these log types are sent. Empty (the default) sends alerts from every log type. " +
+				"Checked against the instance's schemas at plan time.",
+		},
+	}
+}
+
+// allOfListAttribute is an optional, non-empty list of values from allowed that
+// defaults to all of them.
+func allOfListAttribute(allowed []string, description string) schema.ListAttribute {
+	all := make([]attr.Value, 0, len(allowed))
+	for _, v := range allowed {
+		all = append(all, types.StringValue(v))
+	}
+	return schema.ListAttribute{
+		ElementType:         types.StringType,
+		Optional:            true,
+		Computed:            true,
+		Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, all)),
+		MarkdownDescription: description + " Any of `" + strings.Join(allowed, "`, `") + "`.",
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.UniqueValues(),
+			listvalidator.ValueStringsAre(stringvalidator.OneOf(allowed...)),
+		},
+	}
+}
+
+func (m *destinationModel) core() *destinationModel {
+	return m
+}
+
+func (m destinationModel) toCore(ctx context.Context, diagnostics *diag.Diagnostics) client.DestinationCore {
+	return client.DestinationCore{
+		ID:          m.Id.ValueString(),
+		DisplayName: m.DisplayName.ValueString(),
+		Severities:  nonNilStrings(listToStringSlice(ctx, m.Severities, diagnostics)),
+		AlertTypes:  nonNilStrings(listToStringSlice(ctx, m.AlertTypes, diagnostics)),
+		LogTypes:    nonNilStrings(listToStringSlice(ctx, m.LogTypes, diagnostics)),
+	}
+}
+
+// setCore copies the shared fields of an API response into the model. Secrets are
+// left to each resource: the API returns them blank, so the configured value is kept.
+func (m *destinationModel) setCore(ctx context.Context, core client.DestinationCore, diagnostics *diag.Diagnostics) {
+	m.Id = types.StringValue(core.ID)
+	m.DisplayName = types.StringValue(core.DisplayName)
+	m.Severities = stringSliceToList(ctx, nonNilStrings(core.Severities), diagnostics)
+	m.AlertTypes = stringSliceToList(ctx, nonNilStrings(core.AlertTypes), diagnostics)
+	m.LogTypes = stringSliceToList(ctx, nonNilStrings(core.LogTypes), diagnostics)
+}
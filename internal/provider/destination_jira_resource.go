@@ -0,0 +1,129 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const jiraDestinationPath = destinationPath + "/jira"
+
+func NewJiraDestinationResource() resource.Resource {
+	return &destinationResource[jiraDestinationModel, client.JiraDestination, *jiraDestinationModel]{
+		typeName: "_destination_jira",
+		name:     "Jira Destination",
+		path:     jiraDestinationPath,
+		secret:   "api_key",
+		schema:   jiraDestinationSchema,
+	}
+}
+
+// jiraDestinationModel adds the Jira fields to destinationModel. The *Wo fields are
+// only ever set in config; see secretValue.
+type jiraDestinationModel struct {
+	destinationModel
+	OrgDomain       types.String `tfsdk:"org_domain"`
+	ProjectKey      types.String `tfsdk:"project_key"`
+	UserName        types.String `tfsdk:"user_name"`
+	APIKey          types.String `tfsdk:"api_key"`
+	APIKeyWo        types.String `tfsdk:"api_key_wo"`
+	APIKeyWoVersion types.Int64  `tfsdk:"api_key_wo_version"`
+	AssigneeID      types.String `tfsdk:"assignee_id"`
+	IssueType       types.String `tfsdk:"issue_type"`
+	Labels          types.List   `tfsdk:"labels"`
+}
+
+func jiraDestinationSchema() schema.Schema {
+	attributes := destinationAttributes()
+	attributes["org_domain"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The Jira Cloud site URL (e.g. `https://example.atlassian.net`).",
+		Validators:          []validator.String{stringvalidator.RegexMatches(regexp.MustCompile(`^https://`), "must be an https URL")},
+	}
+	attributes["project_key"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The key of the project issues are filed in (e.g. `SEC`).",
+		Validators: []validator.String{stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Z][A-Z0-9_]+$`),
+			"must be a Jira project key: uppercase letters, digits and underscores, starting with a letter")},
+	}
+	attributes["user_name"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The email address of the Jira user the API key belongs to.",
+		Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+	attributes["api_key"] = credentialAttribute("The Jira API token of user_name.", stringvalidator.LengthAtLeast(1))
+	attributes["assignee_id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString(""),
+		Description: "The Jira account ID issues are assigned to. Empty leaves issues unassigned.",
+	}
+	attributes["issue_type"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("Task"),
+		MarkdownDescription: "The issue type to create. Defaults to `Task`.",
+	}
+	attributes["labels"] = schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+		Description: "Labels added to every issue.",
+	}
+	return schema.Schema{
+		MarkdownDescription: "Manages a Panther alert destination that files alerts as Jira Cloud issues.",
+		Attributes:          attributes,
+	}
+}
+
+func (m jiraDestinationModel) toAPI(ctx context.Context, config tfsdk.Config, diagnostics *diag.Diagnostics) client.JiraDestination {
+	return client.JiraDestination{
+		DestinationCore: m.toCore(ctx, diagnostics),
+		OrgDomain:       m.OrgDomain.ValueString(),
+		ProjectKey:      m.ProjectKey.ValueString(),
+		UserName:        m.UserName.ValueString(),
+		APIKey:          secretValue(ctx, config, "api_key", m.APIKey, diagnostics),
+		AssigneeID:      m.AssigneeID.ValueString(),
+		IssueType:       m.IssueType.ValueString(),
+		Labels:          nonNilStrings(listToStringSlice(ctx, m.Labels, diagnostics)),
+	}
+}
+
+func (m *jiraDestinationModel) set(ctx context.Context, destination client.JiraDestination, diagnostics *diag.Diagnostics) {
+	m.setCore(ctx, destination.DestinationCore, diagnostics)
+	m.OrgDomain = types.StringValue(destination.OrgDomain)
+	m.ProjectKey = types.StringValue(destination.ProjectKey)
+	m.UserName = types.StringValue(destination.UserName)
+	m.AssigneeID = types.StringValue(destination.AssigneeID)
+	m.IssueType = types.StringValue(destination.IssueType)
+	m.Labels = stringSliceToList(ctx, nonNilStrings(destination.Labels), diagnostics)
+}
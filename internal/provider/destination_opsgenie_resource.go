@@ -0,0 +1,83 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const opsgenieDestinationPath = destinationPath + "/opsgenie"
+
+func NewOpsgenieDestinationResource() resource.Resource {
+	return &destinationResource[opsgenieDestinationModel, client.OpsgenieDestination, *opsgenieDestinationModel]{
+		typeName: "_destination_opsgenie",
+		name:     "Opsgenie Destination",
+		path:     opsgenieDestinationPath,
+		secret:   "api_key",
+		schema:   opsgenieDestinationSchema,
+	}
+}
+
+// opsgenieDestinationModel adds the Opsgenie fields to destinationModel. The *Wo fields
+// are only ever set in config; see secretValue.
+type opsgenieDestinationModel struct {
+	destinationModel
+	APIKey          types.String `tfsdk:"api_key"`
+	APIKeyWo        types.String `tfsdk:"api_key_wo"`
+	APIKeyWoVersion types.Int64  `tfsdk:"api_key_wo_version"`
+	ServiceRegion   types.String `tfsdk:"service_region"`
+}
+
+func opsgenieDestinationSchema() schema.Schema {
+	attributes := destinationAttributes()
+	attributes["api_key"] = credentialAttribute("The API key of an Opsgenie API integration.", stringvalidator.LengthAtLeast(1))
+	attributes["service_region"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("US"),
+		MarkdownDescription: "The Opsgenie region of the account: `US` (the default) or `EU`.",
+		Validators:          []validator.String{stringvalidator.OneOf("US", "EU")},
+	}
+	return schema.Schema{
+		MarkdownDescription: "Manages a Panther alert destination that creates Opsgenie alerts.",
+		Attributes:          attributes,
+	}
+}
+
+func (m opsgenieDestinationModel) toAPI(ctx context.Context, config tfsdk.Config, diagnostics *diag.Diagnostics) client.OpsgenieDestination {
+	return client.OpsgenieDestination{
+		DestinationCore: m.toCore(ctx, diagnostics),
+		APIKey:          secretValue(ctx, config, "api_key", m.APIKey, diagnostics),
+		ServiceRegion:   m.ServiceRegion.ValueString(),
+	}
+}
+
+func (m *opsgenieDestinationModel) set(ctx context.Context, destination client.OpsgenieDestination, diagnostics *diag.Diagnostics) {
+	m.setCore(ctx, destination.DestinationCore, diagnostics)
+	m.ServiceRegion = types.StringValue(destination.ServiceRegion)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const pagerDutyDestinationPath = destinationPath + "/pagerduty"
+
+func NewPagerDutyDestinationResource() resource.Resource {
+	return &destinationResource[pagerDutyDestinationModel, client.PagerDutyDestination, *pagerDutyDestinationModel]{
+		typeName: "_destination_pagerduty",
+		name:     "PagerDuty Destination",
+		path:     pagerDutyDestinationPath,
+		secret:   "integration_key",
+		schema:   pagerDutyDestinationSchema,
+	}
+}
+
+// pagerDutyDestinationModel adds the PagerDuty fields to destinationModel. The *Wo
+// fields are only ever set in config; see secretValue.
+type pagerDutyDestinationModel struct {
+	destinationModel
+	IntegrationKey          types.String `tfsdk:"integration_key"`
+	IntegrationKeyWo        types.String `tfsdk:"integration_key_wo"`
+	IntegrationKeyWoVersion types.Int64  `tfsdk:"integration_key_wo_version"`
+}
+
+func pagerDutyDestinationSchema() schema.Schema {
+	attributes := destinationAttributes()
+	attributes["integration_key"] = credentialAttribute("The integration key of a PagerDuty Events API v2 integration.",
+		stringvalidator.LengthBetween(32, 32))
+	return schema.Schema{
+		MarkdownDescription: "Manages a Panther alert destination that triggers PagerDuty incidents through an Events API v2 integration.",
+		Attributes:          attributes,
+	}
+}
+
+func (m pagerDutyDestinationModel) toAPI(ctx context.Context, config tfsdk.Config, diagnostics *diag.Diagnostics) client.PagerDutyDestination {
+	return client.PagerDutyDestination{
+		DestinationCore: m.toCore(ctx, diagnostics),
+		IntegrationKey:  secretValue(ctx, config, "integration_key", m.IntegrationKey, diagnostics),
+	}
+}
+
+func (m *pagerDutyDestinationModel) set(ctx context.Context, destination client.PagerDutyDestination, diagnostics *diag.Diagnostics) {
+	m.setCore(ctx, destination.DestinationCore, diagnostics)
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestDestinationResources covers create with default filters, import, and narrowing
+// the filters, for every destination kind.
+func TestDestinationResources(t *testing.T) {
+	cases := []struct {
+		kind    string
+		fields  string
+		secrets []string
+	}{
+		{"slack", `webhook_url = "https://hooks.slack.com/services/T000/B000/XXXX"`, []string{"webhook_url"}},
+		{"pagerduty", `integration_key = "0123456789abcdef0123456789abcdef"`, []string{"integration_key"}},
+		{"webhook", `url = "https://alerts.example.com/panther?token=s3cr3t"`, []string{"url"}},
+		{"sns", `topic_arn = "arn:aws:sns:us-east-1:123456789012:panther-alerts"`, nil},
+		{"jira", `org_domain  = "https://example.atlassian.net"
+  project_key = "SEC"
+  user_name   = "panther@example.com"
+  api_key     = "s3cr3t"
+  labels      = ["panther"]`, []string{"api_key"}},
+		{"opsgenie", `api_key        = "s3cr3t"
+  service_region = "EU"`, []string{"api_key"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.kind, func(t *testing.T) {
+			name := "test-" + tc.kind + "-" + uuid.NewString()
+			address := "panther_destination_" + tc.kind + ".test"
+			config := func(filters string) string {
+				return providerConfig + fmt.Sprintf(`
+resource "panther_destination_%s" "test" {
+  display_name = %q
+  %s
+  %s
+}
+`, tc.kind, name, tc.fields, filters)
+			}
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: config(""),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttrSet(address, "id"),
+							resource.TestCheckResourceAttr(address, "display_name", name),
+							resource.TestCheckResourceAttr(address, "severities.#", "5"),
+							resource.TestCheckResourceAttr(address, "alert_types.#", "6"),
+							resource.TestCheckResourceAttr(address, "log_types.#", "0"),
+						),
+					},
+					{
+						ResourceName:            address,
+						ImportState:             true,
+						ImportStateVerify:       true,
+						ImportStateVerifyIgnore: tc.secrets,
+					},
+					{
+						Config: config(`severities  = ["HIGH", "CRITICAL"]
+  alert_types = ["RULE", "SYSTEM_ERROR"]
+  log_types   = ["AWS.CloudTrail"]`),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr(address, "severities.#", "2"),
+							resource.TestCheckResourceAttr(address, "alert_types.1", "SYSTEM_ERROR"),
+							resource.TestCheckResourceAttr(address, "log_types.0", "AWS.CloudTrail"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+// TestDestinationResource_DetectionOverride routes a rule's alerts to a destination
+// through destination_ids.
+func TestDestinationResource_DetectionOverride(t *testing.T) {
+	id := "Test.Rule." + strings.ReplaceAll(uuid.NewString(), "-", "")
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "panther_destination_slack" "test" {
+  display_name = "test-override-%s"
+  webhook_url  = "https://hooks.slack.com/services/T000/B000/XXXX"
+}
+
+resource "panther_rule" "test" {
+  id              = %q
+  severity        = "HIGH"
+  log_types       = ["AWS.CloudTrail"]
+  body            = "def rule(event):\n    return True\n"
+  destination_ids = [panther_destination_slack.test.id]
+}
+`, id, id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("panther_rule.test", "destination_ids.0", "panther_destination_slack.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+// TestDestinationResource_WriteOnly creates a webhook destination from url_wo and
+// rotates the URL by bumping url_wo_version. The URL must never reach state.
+func TestDestinationResource_WriteOnly(t *testing.T) {
+	name := "test-webhook-wo-" + uuid.NewString()
+	config := func(version int) string {
+		return providerConfig + fmt.Sprintf(`
+resource "panther_destination_webhook" "test" {
+  display_name   = %q
+  url_wo         = "https://alerts.example.com/panther?token=s3cr3t-%d"
+  url_wo_version = %d
+}
+`, name, version, version)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("panther_destination_webhook.test", "id"),
+					resource.TestCheckResourceAttr("panther_destination_webhook.test", "url", ""),
+					resource.TestCheckNoResourceAttr("panther_destination_webhook.test", "url_wo"),
+				),
+			},
+			{
+				Config: config(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_destination_webhook.test", "url_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("panther_destination_webhook.test", "url_wo"),
+				),
+			},
+		},
+	})
+}
+
+// TestDestinationResource_MissingSecret checks that every destination kind with a secret
+// refuses, at plan time, a configuration with neither the secret nor its write-only variant.
+func TestDestinationResource_MissingSecret(t *testing.T) {
+	cases := map[string]struct {
+		fields, secret string
+	}{
+		"slack":     {"", "webhook_url"},
+		"pagerduty": {"", "integration_key"},
+		"webhook":   {"", "url"},
+		"jira": {`org_domain  = "https://example.atlassian.net"
+  project_key = "SEC"
+  user_name   = "panther@example.com"`, "api_key"},
+		"opsgenie": {"", "api_key"},
+	}
+	for kind, tc := range cases {
+		t.Run(kind, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_destination_%s" "test" {
+  display_name = "plan-time-validation"
+  %s
+}
+`, kind, tc.fields),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(`(?s)Missing Attribute Configuration.*\[` + tc.secret + `,` + tc.secret + `_wo\]`),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestDestinationResource_PlanTimeValidation(t *testing.T) {
+	cases := []struct {
+		name        string
+		attributes  string
+		expectError string
+	}{
+		{"webhook_url", `webhook_url = "https://example.com/hook"`, `must be a Slack incoming webhook URL`},
+		{"severity", `webhook_url = "https://hooks.slack.com/services/T000/B000/XXXX"
+  severities  = ["SEVERE"]`, `(?s)severities\[0\].*value must be one of`},
+		{"empty_alert_types", `webhook_url = "https://hooks.slack.com/services/T000/B000/XXXX"
+  alert_types = []`, `alert_types list must contain at least 1`},
+		{"unknown_log_type", `webhook_url = "https://hooks.slack.com/services/T000/B000/XXXX"
+  log_types   = ["AWS.Cloudtrail"]`, `Did you\s+mean\s+"AWS.CloudTrail"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_destination_slack" "test" {
+  display_name = "test-validation"
+  %s
+}
+`, tc.attributes),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.expectError),
+					},
+				},
+			})
+		})
+	}
+}
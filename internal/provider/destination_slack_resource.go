@@ -0,0 +1,74 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const slackDestinationPath = destinationPath + "/slack"
+
+func NewSlackDestinationResource() resource.Resource {
+	return &destinationResource[slackDestinationModel, client.SlackDestination, *slackDestinationModel]{
+		typeName: "_destination_slack",
+		name:     "Slack Destination",
+		path:     slackDestinationPath,
+		secret:   "webhook_url",
+		schema:   slackDestinationSchema,
+	}
+}
+
+// slackDestinationModel adds the Slack fields to destinationModel. The *Wo fields are
+// only ever set in config; see secretValue.
+type slackDestinationModel struct {
+	destinationModel
+	WebhookURL          types.String `tfsdk:"webhook_url"`
+	WebhookURLWo        types.String `tfsdk:"webhook_url_wo"`
+	WebhookURLWoVersion types.Int64  `tfsdk:"webhook_url_wo_version"`
+}
+
+func slackDestinationSchema() schema.Schema {
+	attributes := destinationAttributes()
+	attributes["webhook_url"] = credentialAttribute("The Slack incoming webhook URL alerts are posted to.",
+		stringvalidator.LengthAtLeast(1),
+		stringvalidator.RegexMatches(regexp.MustCompile(`^https://hooks\.slack\.com/`), "must be a Slack incoming webhook URL"))
+	return schema.Schema{
+		MarkdownDescription: "Manages a Panther alert destination that posts to a Slack channel through an incoming webhook.",
+		Attributes:          attributes,
+	}
+}
+
+func (m slackDestinationModel) toAPI(ctx context.Context, config tfsdk.Config, diagnostics *diag.Diagnostics) client.SlackDestination {
+	return client.SlackDestination{
+		DestinationCore: m.toCore(ctx, diagnostics),
+		WebhookURL:      secretValue(ctx, config, "webhook_url", m.WebhookURL, diagnostics),
+	}
+}
+
+func (m *slackDestinationModel) set(ctx context.Context, destination client.SlackDestination, diagnostics *diag.Diagnostics) {
+	m.setCore(ctx, destination.DestinationCore, diagnostics)
+}
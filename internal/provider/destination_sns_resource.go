@@ -0,0 +1,76 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const snsDestinationPath = destinationPath + "/sns"
+
+var snsTopicARNRegex = regexp.MustCompile(`^arn:aws[a-z-]*:sns:[a-z]{2}(?:-gov)?-[a-z]+-\d+:\d{12}:[A-Za-z0-9_-]{1,256}(?:\.fifo)?$`)
+
+func NewSNSDestinationResource() resource.Resource {
+	return &destinationResource[snsDestinationModel, client.SNSDestination, *snsDestinationModel]{
+		typeName: "_destination_sns",
+		name:     "SNS Destination",
+		path:     snsDestinationPath,
+		schema:   snsDestinationSchema,
+	}
+}
+
+type snsDestinationModel struct {
+	destinationModel
+	TopicARN types.String `tfsdk:"topic_arn"`
+}
+
+func snsDestinationSchema() schema.Schema {
+	attributes := destinationAttributes()
+	attributes["topic_arn"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The ARN of the SNS topic alerts are published to. Its access policy must let Panther publish.",
+		Validators: []validator.String{stringvalidator.RegexMatches(snsTopicARNRegex,
+			"must be an SNS topic ARN (e.g. arn:aws:sns:us-east-1:123456789012:panther-alerts)")},
+	}
+	return schema.Schema{
+		MarkdownDescription: "Manages a Panther alert destination that publishes alerts to an Amazon SNS topic.",
+		Attributes:          attributes,
+	}
+}
+
+func (m snsDestinationModel) toAPI(ctx context.Context, _ tfsdk.Config, diagnostics *diag.Diagnostics) client.SNSDestination {
+	return client.SNSDestination{
+		DestinationCore: m.toCore(ctx, diagnostics),
+		TopicARN:        m.TopicARN.ValueString(),
+	}
+}
+
+func (m *snsDestinationModel) set(ctx context.Context, destination client.SNSDestination, diagnostics *diag.Diagnostics) {
+	m.setCore(ctx, destination.DestinationCore, diagnostics)
+	m.TopicARN = types.StringValue(destination.TopicARN)
+}
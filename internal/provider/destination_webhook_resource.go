@@ -0,0 +1,74 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const webhookDestinationPath = destinationPath + "/webhook"
+
+func NewWebhookDestinationResource() resource.Resource {
+	return &destinationResource[webhookDestinationModel, client.WebhookDestination, *webhookDestinationModel]{
+		typeName: "_destination_webhook",
+		name:     "Webhook Destination",
+		path:     webhookDestinationPath,
+		secret:   "url",
+		schema:   webhookDestinationSchema,
+	}
+}
+
+// webhookDestinationModel adds the webhook fields to destinationModel. The *Wo fields
+// are only ever set in config; see secretValue.
+type webhookDestinationModel struct {
+	destinationModel
+	URL          types.String `tfsdk:"url"`
+	URLWo        types.String `tfsdk:"url_wo"`
+	URLWoVersion types.Int64  `tfsdk:"url_wo_version"`
+}
+
+func webhookDestinationSchema() schema.Schema {
+	attributes := destinationAttributes()
+	attributes["url"] = credentialAttribute("The HTTPS URL the alert JSON is POSTed to. Treated as a secret because webhook URLs usually embed a token.",
+		stringvalidator.LengthAtLeast(1),
+		stringvalidator.RegexMatches(regexp.MustCompile(`^https://`), "must be an https URL"))
+	return schema.Schema{
+		MarkdownDescription: "Manages a Panther alert destination that POSTs each alert as JSON to a custom webhook.",
+		Attributes:          attributes,
+	}
+}
+
+func (m webhookDestinationModel) toAPI(ctx context.Context, config tfsdk.Config, diagnostics *diag.Diagnostics) client.WebhookDestination {
+	return client.WebhookDestination{
+		DestinationCore: m.toCore(ctx, diagnostics),
+		URL:             secretValue(ctx, config, "url", m.URL, diagnostics),
+	}
+}
+
+func (m *webhookDestinationModel) set(ctx context.Context, destination client.WebhookDestination, diagnostics *diag.Diagnostics) {
+	m.setCore(ctx, destination.DestinationCore, diagnostics)
+}
@@ -0,0 +1,294 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Detections (panther_rule, panther_scheduled_rule, panther_policy) are hand-written
+// resources over endpoints that share most of their body; this file holds the shared
+// schema and model conversion.
+
+const (
+	rulePath          = "/rules"
+	scheduledRulePath = "/scheduled-rules"
+	policyPath        = "/policies"
+)
+
+var detectionSeverities = []string{"INFO", "LOW", "MEDIUM", "HIGH", "CRITICAL"}
+
+var (
+	detectionReportsType   = types.MapType{ElemType: types.ListType{ElemType: types.StringType}}
+	detectionTestAttrTypes = map[string]attr.Type{
+		"name":            types.StringType,
+		"expected_result": types.BoolType,
+		"resource":        jsonType{},
+	}
+)
+
+// detectionModel holds the attributes every detection has; each resource's model
+// embeds it.
+type detectionModel struct {
+	Id             types.String `tfsdk:"id"`
+	DisplayName    types.String `tfsdk:"display_name"`
+	Body           types.String `tfsdk:"body"`
+	Description    types.String `tfsdk:"description"`
+	Severity       types.String `tfsdk:"severity"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Tags           types.List   `tfsdk:"tags"`
+	Runbook        types.String `tfsdk:"runbook"`
+	Reference      types.String `tfsdk:"reference"`
+	Reports        types.Map    `tfsdk:"reports"`
+	Tests          types.List   `tfsdk:"tests"`
+	DestinationIds types.List   `tfsdk:"destination_ids"`
+}
+
+type detectionTestModel struct {
+	Name           types.String `tfsdk:"name"`
+	ExpectedResult types.Bool   `tfsdk:"expected_result"`
+	Resource       jsonValue    `tfsdk:"resource"`
+}
+
+// detectionAttributes returns the schema attributes every detection has. subject is
+// what the detection inspects ("event" or "resource"), used in descriptions.
+func detectionAttributes(subject string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Required: true,
+			MarkdownDescription: "The detection ID, unique across detections of this type (e.g. `AWS.CloudTrail.RootLogin`). " +
+				"Changing this forces a new detection.",
+			Validators:    []validator.String{stringvalidator.LengthAtLeast(1)},
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+		},
+		"display_name": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(""),
+			Description: "The name shown in the Panther console and on alerts. Defaults to the ID when empty.",
+		},
+		"body": schema.StringAttribute{
+			Required:    true,
+			Description: "The Python source of the detection. It must define a " + detectionEntryPoint(subject) + " function.",
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "What the detection looks for, shown on alerts.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(""),
+		},
+		"severity": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "The severity of alerts the detection raises. One of `" + strings.Join(detectionSeverities, "`, `") + "`.",
+			Validators:          []validator.String{stringvalidator.OneOf(detectionSeverities...)},
+		},
+		"enabled": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+			Description: "Whether the detection runs. Defaults to true.",
+		},
+		"tags": schema.ListAttribute{
+			MarkdownDescription: "Free-form labels for filtering detections in the console.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+		},
+		"runbook": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(""),
+			Description: "Triage steps included in alerts.",
+		},
+		"reference": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(""),
+			Description: "A link to background on what the detection looks for.",
+		},
+		"reports": schema.MapAttribute{
+			ElementType:         types.ListType{ElemType: types.StringType},
+			Optional:            true,
+			Computed:            true,
+			Default:             mapdefault.StaticValue(types.MapValueMust(detectionReportsType.ElemType, map[string]attr.Value{})),
+			MarkdownDescription: "Compliance framework mappings, keyed by framework (e.g. `MITRE ATT&CK = [\"TA0001:T1078\"]`).",
+		},
+		"destination_ids": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			MarkdownDescription: "IDs of `panther_destination_*` resources to send this detection's alerts to, " +
+				"overriding the destinations whose filters match. Empty routes alerts by the destinations' filters.",
+		},
+		"tests": schema.ListNestedAttribute{
+			Optional: true,
+			Computed: true,
+			Default: listdefault.StaticValue(types.ListValueMust(
+				types.ObjectType{AttrTypes: detectionTestAttrTypes}, []attr.Value{})),
+			Description: "Unit tests Panther runs when the detection is saved. A failing test fails the apply.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required: true,
+					},
+					"expected_result": schema.BoolAttribute{
+						Required:    true,
+						Description: "Whether the detection should match the test " + subject + ".",
+					},
+					"resource": schema.StringAttribute{
+						CustomType: jsonType{},
+						Required:   true,
+						MarkdownDescription: "The test " + subject + " as a JSON document, e.g. from `jsonencode()`. Compared " +
+							"semantically, so the API reformatting the document does not show up as drift.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func detectionEntryPoint(subject string) string {
+	if subject == "resource" {
+		return "`policy(resource)`"
+	}
+	return "`rule(event)`"
+}
+
+// alertGroupingAttributes are the rule and scheduled rule attributes controlling how
+// matches become alerts.
+func alertGroupingAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"dedup_period_minutes": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(60),
+			Description: "How long, in minutes, matches with the same dedup string are grouped into one alert. Defaults to 60.",
+			Validators:  []validator.Int64{int64validator.Between(5, 1440)},
+		},
+		"threshold": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(1),
+			Description: "How many matches within the dedup period raise an alert. Defaults to 1.",
+			Validators:  []validator.Int64{int64validator.AtLeast(1)},
+		},
+	}
+}
+
+// requiredStringListAttribute is a required, non-empty list of strings.
+func requiredStringListAttribute(description string) schema.ListAttribute {
+	return schema.ListAttribute{
+		ElementType:         types.StringType,
+		Required:            true,
+		MarkdownDescription: description,
+		Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+	}
+}
+
+func (m detectionModel) toCore(ctx context.Context, diagnostics *diag.Diagnostics) client.DetectionCore {
+	core := client.DetectionCore{
+		ID:          m.Id.ValueString(),
+		DisplayName: m.DisplayName.ValueString(),
+		Body:        m.Body.ValueString(),
+		Description: m.Description.ValueString(),
+		Severity:    m.Severity.ValueString(),
+		Enabled:     m.Enabled.ValueBool(),
+		Tags:        listToStringSlice(ctx, m.Tags, diagnostics),
+		Runbook:     m.Runbook.ValueString(),
+		Reference:   m.Reference.ValueString(),
+		Reports:     map[string][]string{},
+		Tests:       []client.DetectionTest{},
+		OutputIDs:   nonNilStrings(listToStringSlice(ctx, m.DestinationIds, diagnostics)),
+	}
+	if core.Tags == nil {
+		core.Tags = []string{}
+	}
+	diagnostics.Append(m.Reports.ElementsAs(ctx, &core.Reports, false)...)
+	var tests []detectionTestModel
+	diagnostics.Append(m.Tests.ElementsAs(ctx, &tests, false)...)
+	for _, test := range tests {
+		core.Tests = append(core.Tests, client.DetectionTest{
+			Name:           test.Name.ValueString(),
+			ExpectedResult: test.ExpectedResult.ValueBool(),
+			Resource:       test.Resource.ValueString(),
+		})
+	}
+	return core
+}
+
+// setCore copies the shared fields of an API response into the model. Missing lists
+// and maps become empty ones, matching the attributes' defaults.
+func (m *detectionModel) setCore(ctx context.Context, core client.DetectionCore, diagnostics *diag.Diagnostics) {
+	m.Id = types.StringValue(core.ID)
+	m.DisplayName = types.StringValue(core.DisplayName)
+	m.Body = types.StringValue(core.Body)
+	m.Description = types.StringValue(core.Description)
+	m.Severity = types.StringValue(core.Severity)
+	m.Enabled = types.BoolValue(core.Enabled)
+	m.Tags = stringSliceToList(ctx, nonNilStrings(core.Tags), diagnostics)
+	m.Runbook = types.StringValue(core.Runbook)
+	m.Reference = types.StringValue(core.Reference)
+	m.DestinationIds = stringSliceToList(ctx, nonNilStrings(core.OutputIDs), diagnostics)
+
+	reports := core.Reports
+	if reports == nil {
+		reports = map[string][]string{}
+	}
+	var d diag.Diagnostics
+	m.Reports, d = types.MapValueFrom(ctx, detectionReportsType.ElemType, reports)
+	diagnostics.Append(d...)
+
+	tests := make([]detectionTestModel, 0, len(core.Tests))
+	for _, test := range core.Tests {
+		tests = append(tests, detectionTestModel{
+			Name:           types.StringValue(test.Name),
+			ExpectedResult: types.BoolValue(test.ExpectedResult),
+			Resource:       newJSONValue(test.Resource),
+		})
+	}
+	m.Tests, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: detectionTestAttrTypes}, tests)
+	diagnostics.Append(d...)
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
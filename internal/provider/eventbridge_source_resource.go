@@ -0,0 +1,201 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"terraform-provider-panther/internal/client"
+	"terraform-provider-panther/internal/provider/resource_eventbridge_source"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const eventBridgeSourcePath = "/log-sources/eventbridge"
+
+// eventBusNameRegex follows EventBridge's naming rules; partner event buses contain slashes
+// (aws.partner/example.com/123/events).
+var eventBusNameRegex = regexp.MustCompile(`^[/.\-_A-Za-z0-9]{1,256}$`)
+
+var (
+	_ resource.Resource                = (*eventBridgeSourceResource)(nil)
+	_ resource.ResourceWithConfigure   = (*eventBridgeSourceResource)(nil)
+	_ resource.ResourceWithImportState = (*eventBridgeSourceResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*eventBridgeSourceResource)(nil)
+)
+
+func NewEventBridgeSourceResource() resource.Resource {
+	return &eventBridgeSourceResource{}
+}
+
+type eventBridgeSourceResource struct {
+	rest *client.RESTClient
+}
+
+func (r *eventBridgeSourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_eventbridge_source"
+}
+
+func (r *eventBridgeSourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_eventbridge_source.EventbridgeSourceResourceSchema(ctx)
+	resp.Schema.MarkdownDescription = "Represents an EventBridge Log Source in Panther, which ingests the events " +
+		"published to an event bus."
+	// The API can't move a source to another bus; a different bus is a new source.
+	applySchemaOverrides(&resp.Schema, []SchemaOverride{
+		{Name: "id", PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+		{
+			Name:          "aws_account_id",
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators:    []validator.String{awsAccountIDValidator()},
+		},
+		{
+			Name:          "region",
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators:    []validator.String{awsRegionValidator()},
+		},
+		{
+			Name:          "bus_name",
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators: []validator.String{stringvalidator.RegexMatches(eventBusNameRegex,
+				"must be an event bus name: up to 256 letters, digits and . - _ /")},
+		},
+	})
+}
+
+func (r *eventBridgeSourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateLogTypes(ctx, r.rest, req, resp, logTypesExpression)
+}
+
+func (r *eventBridgeSourceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+func (r *eventBridgeSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_eventbridge_source.EventbridgeSourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := eventBridgeSourceInput(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eventBridgeSource, err := client.RestDo[client.EventBridgeSource](ctx, r.rest, http.MethodPost, eventBridgeSourcePath, input)
+	if handleCreateError(resp, "EventBridge Source", err) {
+		return
+	}
+	tflog.Debug(ctx, "Created EventBridge Source", map[string]any{
+		"id": eventBridgeSource.IntegrationId,
+	})
+
+	setEventBridgeSource(ctx, &data, eventBridgeSource, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *eventBridgeSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_eventbridge_source.EventbridgeSourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eventBridgeSource, err := client.RestDo[client.EventBridgeSource](ctx, r.rest, http.MethodGet, eventBridgeSourcePath+"/"+data.Id.ValueString(), nil)
+	if handleReadError(ctx, resp, "EventBridge Source", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Got EventBridge Source", map[string]any{
+		"id": eventBridgeSource.IntegrationId,
+	})
+
+	setEventBridgeSource(ctx, &data, eventBridgeSource, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *eventBridgeSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_eventbridge_source.EventbridgeSourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := eventBridgeSourceInput(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eventBridgeSource, err := client.RestDo[client.EventBridgeSource](ctx, r.rest, http.MethodPut, eventBridgeSourcePath+"/"+data.Id.ValueString(), input)
+	if handleUpdateError(ctx, resp, "EventBridge Source", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated EventBridge Source", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+
+	setEventBridgeSource(ctx, &data, eventBridgeSource, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *eventBridgeSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_eventbridge_source.EventbridgeSourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RestDelete(ctx, r.rest, eventBridgeSourcePath+"/"+data.Id.ValueString())
+	if handleDeleteError(resp, "EventBridge Source", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Deleted EventBridge Source", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *eventBridgeSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func eventBridgeSourceInput(ctx context.Context, data resource_eventbridge_source.EventbridgeSourceModel, diagnostics *diag.Diagnostics) client.EventBridgeSourceInput {
+	return client.EventBridgeSourceInput{
+		IntegrationLabel: data.IntegrationLabel.ValueString(),
+		AwsAccountId:     data.AwsAccountId.ValueString(),
+		Region:           data.Region.ValueString(),
+		BusName:          data.BusName.ValueString(),
+		LogTypes:         listToStringSlice(ctx, data.LogTypes, diagnostics),
+	}
+}
+
+// setEventBridgeSource maps the API response to state. The source has no secrets, so
+// every field comes from the API.
+func setEventBridgeSource(ctx context.Context, data *resource_eventbridge_source.EventbridgeSourceModel, eventBridgeSource client.EventBridgeSource, diagnostics *diag.Diagnostics) {
+	data.Id = types.StringValue(eventBridgeSource.IntegrationId)
+	data.IntegrationLabel = types.StringValue(eventBridgeSource.IntegrationLabel)
+	data.AwsAccountId = types.StringValue(eventBridgeSource.AwsAccountId)
+	data.Region = types.StringValue(eventBridgeSource.Region)
+	data.BusName = types.StringValue(eventBridgeSource.BusName)
+	data.LogTypes = stringSliceToList(ctx, eventBridgeSource.LogTypes, diagnostics)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestEventBridgeSourceResource covers create on a partner event bus, import, and an
+// update of the label and log types.
+func TestEventBridgeSourceResource(t *testing.T) {
+	label := "test-eventbridge-" + uuid.NewString()
+	config := func(label, logTypes string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "panther_eventbridge_source" "test" {
+  integration_label = %q
+  aws_account_id    = "123456789012"
+  region            = "us-gov-west-1"
+  bus_name          = "aws.partner/okta.com/example/events"
+  log_types         = %s
+}
+`, label, logTypes)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(label, `["Okta.SystemLog"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("panther_eventbridge_source.test", "id"),
+					resource.TestCheckResourceAttr("panther_eventbridge_source.test", "bus_name", "aws.partner/okta.com/example/events"),
+				),
+			},
+			{
+				ResourceName:      "panther_eventbridge_source.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(label+"-renamed", `["Okta.SystemLog", "AWS.GuardDuty"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_eventbridge_source.test", "integration_label", label+"-renamed"),
+					resource.TestCheckResourceAttr("panther_eventbridge_source.test", "log_types.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+// TestEventBridgeSourceResource_PlanTimeValidation covers errors raised before any API call.
+func TestEventBridgeSourceResource_PlanTimeValidation(t *testing.T) {
+	cases := map[string]struct {
+		account, region, bus string
+		err                  string
+	}{
+		"account_too_short": {account: "12345", region: "us-east-1", bus: "default", err: `12-digit AWS account\s+ID`},
+		"region_uppercase":  {account: "123456789012", region: "US-EAST-1", bus: "default", err: `valid AWS region\s+code`},
+		"bus_arn": {
+			account: "123456789012", region: "us-east-1", bus: "arn:aws:events:us-east-1:123456789012:event-bus/default",
+			err: `must be an event bus\s+name`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_eventbridge_source" "test" {
+  integration_label = "plan-time-validation"
+  aws_account_id    = %q
+  region            = %q
+  bus_name          = %q
+  log_types         = ["AWS.GuardDuty"]
+}
+`, tc.account, tc.region, tc.bus),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.err),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"maps"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                     = (*gcssourceDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*gcssourceDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*gcssourceDataSource)(nil)
+)
+
+func NewGcssourceDataSource() datasource.DataSource {
+	return &gcssourceDataSource{}
+}
+
+type gcssourceDataSource struct {
+	rest *client.RESTClient
+}
+
+// gcssourceDataSourceModel omits credentials: the API never returns them.
+type gcssourceDataSourceModel struct {
+	Id                   types.String          `tfsdk:"id"`
+	IntegrationLabel     types.String          `tfsdk:"integration_label"`
+	SubscriptionId       types.String          `tfsdk:"subscription_id"`
+	ProjectId            types.String          `tfsdk:"project_id"`
+	GcsBucket            types.String          `tfsdk:"gcs_bucket"`
+	CredentialsType      types.String          `tfsdk:"credentials_type"`
+	LogStreamType        types.String          `tfsdk:"log_stream_type"`
+	LogStreamTypeOptions types.Object          `tfsdk:"log_stream_type_options"`
+	PrefixLogTypes       []PrefixLogTypesModel `tfsdk:"prefix_log_types"`
+}
+
+func (d *gcssourceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gcssource"
+}
+
+func (d *gcssourceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"subscription_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The GCP Pub/Sub subscription ID used to receive GCS bucket notifications.",
+		},
+		"project_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The GCP project ID.",
+		},
+		"gcs_bucket": schema.StringAttribute{
+			Computed:    true,
+			Description: "The GCS bucket name.",
+		},
+		"credentials_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The type of credentials being used: service_account or wif (Workload Identity Federation).",
+		},
+		"log_stream_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The log stream type.",
+		},
+		"log_stream_type_options": logStreamTypeOptionsDataSourceAttribute(),
+		"prefix_log_types":        prefixLogTypesDataSourceAttribute("GCS prefix the log types are mapped to. Empty matches all files in the bucket."),
+	}
+	maps.Copy(attributes, logSourceLookupAttributes("GCS log source", "integration_label"))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing GCS Log Source in Panther by `id` or `integration_label`. " +
+			"Credentials are never returned by the API and are not exposed.",
+		Attributes: attributes,
+	}
+}
+
+func (d *gcssourceDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return logSourceLookupValidators("integration_label")
+}
+
+func (d *gcssourceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.rest = dataSourceRESTClient(req, resp)
+}
+
+func (d *gcssourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data gcssourceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gcsSource, ok := lookupLogSource(ctx, d.rest, gcsSourcePath, "GCS Source", "integration_label", data.Id, data.IntegrationLabel,
+		func(s client.GcsSource) string { return s.IntegrationLabel }, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	tflog.Debug(ctx, "Read GCS Source data source", map[string]any{"id": gcsSource.IntegrationId})
+
+	data.Id = types.StringValue(gcsSource.IntegrationId)
+	data.IntegrationLabel = types.StringValue(gcsSource.IntegrationLabel)
+	data.SubscriptionId = types.StringValue(gcsSource.SubscriptionId)
+	data.ProjectId = types.StringValue(gcsSource.ProjectId)
+	data.GcsBucket = types.StringValue(gcsSource.GcsBucket)
+	data.CredentialsType = types.StringValue(gcsSource.CredentialsType)
+	data.LogStreamType = types.StringValue(gcsSource.LogStreamType)
+	data.LogStreamTypeOptions = types.ObjectNull(logStreamTypeOptionAttrTypes)
+	if opts := gcsSource.LogStreamTypeOptions; opts != nil {
+		data.LogStreamTypeOptions = logStreamTypeOptionsToObject(opts.JsonArrayEnvelopeField, opts.XmlRootElement)
+	}
+	data.PrefixLogTypes = make([]PrefixLogTypesModel, 0, len(gcsSource.PrefixLogTypes))
+	for _, p := range gcsSource.PrefixLogTypes {
+		data.PrefixLogTypes = append(data.PrefixLogTypes, PrefixLogTypesModel{
+			ExcludedPrefixes: stringValues(p.ExcludedPrefixes),
+			LogTypes:         stringValues(p.LogTypes),
+			Prefix:           types.StringValue(p.Prefix),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestGcsSourceDataSource reuses the service-account subscription from
+// TestGcsSourceResource_ServiceAccount. It is deliberately not parallel: Go finishes
+// sequential tests before releasing parallel ones, so the two never hold the
+// subscription at the same time.
+func TestGcsSourceDataSource(t *testing.T) {
+	credentials, projectId, subscriptionId, bucket, ok := loadGcsTestConfig(t,
+		"PANTHER_GCS_SA_CREDENTIALS_FILE",
+		"PANTHER_GCS_SA_PROJECT_ID",
+		"PANTHER_GCS_SA_SUBSCRIPTION_ID",
+		"PANTHER_GCS_SA_BUCKET",
+	)
+	if !ok {
+		t.Skip("Skipping: PANTHER_GCS_SA_CREDENTIALS_FILE, PANTHER_GCS_SA_PROJECT_ID, PANTHER_GCS_SA_SUBSCRIPTION_ID, and PANTHER_GCS_SA_BUCKET must be set")
+	}
+
+	integrationLabel := "tf-automated-test-gcs-datasource"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig +
+					testGcsSourceUpdatedResourceConfig(integrationLabel, subscriptionId, projectId, bucket, credentials, "service_account") + `
+data "panther_gcssource" "by_id" {
+  id = panther_gcssource.test.id
+}
+
+data "panther_gcssource" "by_label" {
+  integration_label = panther_gcssource.test.integration_label
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.panther_gcssource.by_id", "integration_label", "panther_gcssource.test", "integration_label"),
+					resource.TestCheckResourceAttrPair("data.panther_gcssource.by_label", "id", "panther_gcssource.test", "id"),
+					resource.TestCheckResourceAttr("data.panther_gcssource.by_label", "subscription_id", subscriptionId),
+					resource.TestCheckResourceAttr("data.panther_gcssource.by_label", "project_id", projectId),
+					resource.TestCheckResourceAttr("data.panther_gcssource.by_label", "gcs_bucket", bucket),
+					resource.TestCheckResourceAttr("data.panther_gcssource.by_label", "credentials_type", "service_account"),
+					resource.TestCheckResourceAttr("data.panther_gcssource.by_label", "log_stream_type", "JsonArray"),
+					resource.TestCheckResourceAttr("data.panther_gcssource.by_label", "log_stream_type_options.json_array_envelope_field", "records"),
+					resource.TestCheckResourceAttr("data.panther_gcssource.by_label", "prefix_log_types.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr("data.panther_gcssource.by_label", "prefix_log_types.0.excluded_prefixes.0", "logs/tmp/*"),
+					resource.TestCheckNoResourceAttr("data.panther_gcssource.by_label", "credentials"),
+				),
+			},
+		},
+	})
+}
@@ -39,9 +39,11 @@ import (
 const gcsSourcePath = "/log-sources/gcs"
 
 var (
-	_ resource.Resource                = (*gcssourceResource)(nil)
-	_ resource.ResourceWithConfigure   = (*gcssourceResource)(nil)
-	_ resource.ResourceWithImportState = (*gcssourceResource)(nil)
+	_ resource.Resource                     = (*gcssourceResource)(nil)
+	_ resource.ResourceWithConfigure        = (*gcssourceResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*gcssourceResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*gcssourceResource)(nil)
+	_ resource.ResourceWithImportState      = (*gcssourceResource)(nil)
 )
 
 func NewGcssourceResource() resource.Resource {
@@ -52,6 +54,14 @@ type gcssourceResource struct {
 	rest *client.RESTClient
 }
 
+// gcssourceModel is the generated model plus the write-only variants of its
+// secrets. The *Wo fields are only ever set in config; see secretValue.
+type gcssourceModel struct {
+	resource_gcssource.GcssourceModel
+	CredentialsWo        types.String `tfsdk:"credentials_wo"`
+	CredentialsWoVersion types.Int64  `tfsdk:"credentials_wo_version"`
+}
+
 func (r *gcssourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_gcssource"
 }
@@ -61,7 +71,7 @@ func (r *gcssourceResource) Schema(ctx context.Context, req resource.SchemaReque
 	resp.Schema.MarkdownDescription = "Represents a GCS Log Source in Panther"
 	applySchemaOverrides(&resp.Schema, []SchemaOverride{
 		{Name: "id", PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
-		{Name: "credentials", Default: stringdefault.StaticString(""), Sensitive: true},
+		{Name: "credentials", Default: stringdefault.StaticString(""), Sensitive: true, WriteOnly: true},
 		{Name: "project_id", PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
 	})
 
@@ -102,12 +112,20 @@ func (r *gcssourceResource) Schema(ctx context.Context, req resource.SchemaReque
 	resp.Schema.Attributes["prefix_log_types"] = prefixLogTypes
 }
 
+func (r *gcssourceResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{logStreamTypeOptionsValidator{}}
+}
+
+func (r *gcssourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateLogTypes(ctx, r.rest, req, resp, prefixLogTypesExpression)
+}
+
 func (r *gcssourceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	r.rest = restClient(req, resp)
 }
 
 func (r *gcssourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data resource_gcssource.GcssourceModel
+	var data gcssourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -118,7 +136,7 @@ func (r *gcssourceResource) Create(ctx context.Context, req resource.CreateReque
 		SubscriptionId:       data.SubscriptionId.ValueString(),
 		ProjectId:            data.ProjectId.ValueString(),
 		GcsBucket:            data.GcsBucket.ValueString(),
-		Credentials:          data.Credentials.ValueString(),
+		Credentials:          secretValue(ctx, req.Config, "credentials", data.Credentials, &resp.Diagnostics),
 		CredentialsType:      data.CredentialsType.ValueString(),
 		LogStreamType:        data.LogStreamType.ValueString(),
 		LogStreamTypeOptions: gcsLogStreamTypeOptions(data.LogStreamTypeOptions),
@@ -143,7 +161,7 @@ func (r *gcssourceResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *gcssourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data resource_gcssource.GcssourceModel
+	var data gcssourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -187,7 +205,7 @@ func (r *gcssourceResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *gcssourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data resource_gcssource.GcssourceModel
+	var data gcssourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -198,7 +216,7 @@ func (r *gcssourceResource) Update(ctx context.Context, req resource.UpdateReque
 		SubscriptionId:       data.SubscriptionId.ValueString(),
 		ProjectId:            data.ProjectId.ValueString(),
 		GcsBucket:            data.GcsBucket.ValueString(),
-		Credentials:          data.Credentials.ValueString(),
+		Credentials:          secretValue(ctx, req.Config, "credentials", data.Credentials, &resp.Diagnostics),
 		CredentialsType:      data.CredentialsType.ValueString(),
 		LogStreamType:        data.LogStreamType.ValueString(),
 		LogStreamTypeOptions: gcsLogStreamTypeOptions(data.LogStreamTypeOptions),
@@ -218,7 +236,7 @@ func (r *gcssourceResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *gcssourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_gcssource.GcssourceModel
+	var data gcssourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
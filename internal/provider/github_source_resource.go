@@ -0,0 +1,92 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const gitHubSourcePath = "/log-sources/github"
+
+var (
+	gitHubOrganizationRegex = regexp.MustCompile(`^[A-Za-z0-9](?:-?[A-Za-z0-9]){0,38}$`)
+	gitHubTokenRegex        = regexp.MustCompile(`^(ghp_|github_pat_)[A-Za-z0-9_]+$`)
+)
+
+func NewGitHubSourceResource() resource.Resource {
+	return &saasSourceResource[gitHubSourceModel, client.GitHubSource, *gitHubSourceModel]{
+		typeName:   "_github_source",
+		name:       "GitHub Source",
+		path:       gitHubSourcePath,
+		credential: "personal_access_token",
+		schema:     gitHubSourceSchema,
+	}
+}
+
+// gitHubSourceModel adds the GitHub fields to saasSourceModel. The *Wo fields
+// are only ever set in config; see secretValue.
+type gitHubSourceModel struct {
+	saasSourceModel
+	Organization                 types.String `tfsdk:"organization"`
+	PersonalAccessToken          types.String `tfsdk:"personal_access_token"`
+	PersonalAccessTokenWo        types.String `tfsdk:"personal_access_token_wo"`
+	PersonalAccessTokenWoVersion types.Int64  `tfsdk:"personal_access_token_wo_version"`
+	PullIntervalMinutes          types.Int64  `tfsdk:"pull_interval_minutes"`
+}
+
+func gitHubSourceSchema() schema.Schema {
+	attributes := saasSourceAttributes()
+	attributes["organization"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The login of the GitHub organization (as in github.com/<organization>)",
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(gitHubOrganizationRegex, "must be a GitHub organization login"),
+		},
+	}
+	attributes["personal_access_token"] = credentialAttribute("A personal access token of an organization owner with the read:audit_log scope.", stringvalidator.RegexMatches(gitHubTokenRegex, "must be a classic (ghp_...) or fine-grained (github_pat_...) personal access token"))
+	attributes["pull_interval_minutes"] = pullIntervalAttribute()
+	return schema.Schema{
+		MarkdownDescription: "Manages a GitHub log source that pulls the audit log of a GitHub organization.",
+		Attributes:          attributes,
+	}
+}
+
+func (m gitHubSourceModel) toAPI(ctx context.Context, config tfsdk.Config, diagnostics *diag.Diagnostics) client.GitHubSource {
+	return client.GitHubSource{
+		SaasSourceCore:      m.toCore(),
+		Organization:        m.Organization.ValueString(),
+		PersonalAccessToken: secretValue(ctx, config, "personal_access_token", m.PersonalAccessToken, diagnostics),
+		PullIntervalMinutes: m.PullIntervalMinutes.ValueInt64(),
+	}
+}
+
+func (m *gitHubSourceModel) set(ctx context.Context, source client.GitHubSource, diagnostics *diag.Diagnostics) {
+	m.setCore(ctx, source.SaasSourceCore, diagnostics)
+	m.Organization = types.StringValue(source.Organization)
+	m.PullIntervalMinutes = types.Int64Value(source.PullIntervalMinutes)
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const globalHelperPath = "/globals"
+
+// pythonIdentifierRegex matches the names Panther's Python items are imported or
+// called by: global helper modules and data model methods.
+var pythonIdentifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+var (
+	_ resource.Resource                = (*globalHelperResource)(nil)
+	_ resource.ResourceWithConfigure   = (*globalHelperResource)(nil)
+	_ resource.ResourceWithImportState = (*globalHelperResource)(nil)
+)
+
+func NewGlobalHelperResource() resource.Resource {
+	return &globalHelperResource{}
+}
+
+type globalHelperResource struct {
+	rest *client.RESTClient
+}
+
+type globalHelperModel struct {
+	Id          types.String `tfsdk:"id"`
+	Body        types.String `tfsdk:"body"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *globalHelperResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_global_helper"
+}
+
+func (r *globalHelperResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Panther global helper: a Python module shared by detections, which import it by " +
+			"its ID. Reference the helper from detections (e.g. in `depends_on`) so it is created before them and " +
+			"destroyed after them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The module name detections import (e.g. `panther_ip_helpers` for " +
+					"`import panther_ip_helpers`). Changing this forces a new helper.",
+				Validators: []validator.String{stringvalidator.RegexMatches(pythonIdentifierRegex,
+					"must be a valid Python module name: letters, digits and underscores, not starting with a digit")},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"body": schema.StringAttribute{
+				Required:    true,
+				Description: "The Python source of the module.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "What the module provides.",
+			},
+		},
+	}
+}
+
+func (r *globalHelperResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+func (r *globalHelperResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data globalHelperModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	helper, err := client.RestDo[client.GlobalHelper](ctx, r.rest, http.MethodPost, globalHelperPath, data.toAPI())
+	if handleCreateError(resp, "Global Helper", err) {
+		return
+	}
+	tflog.Debug(ctx, "Created Global Helper", map[string]any{"id": helper.ID})
+
+	data.set(helper)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *globalHelperResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data globalHelperModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	helper, err := client.RestDo[client.GlobalHelper](ctx, r.rest, http.MethodGet, globalHelperPath+"/"+data.Id.ValueString(), nil)
+	if handleReadError(ctx, resp, "Global Helper", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Read Global Helper", map[string]any{"id": helper.ID})
+
+	data.set(helper)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *globalHelperResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data globalHelperModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	helper, err := client.RestDo[client.GlobalHelper](ctx, r.rest, http.MethodPut, globalHelperPath+"/"+data.Id.ValueString(), data.toAPI())
+	if handleUpdateError(ctx, resp, "Global Helper", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated Global Helper", map[string]any{"id": data.Id.ValueString()})
+
+	data.set(helper)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *globalHelperResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data globalHelperModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RestDelete(ctx, r.rest, globalHelperPath+"/"+data.Id.ValueString())
+	if handleDeleteError(resp, "Global Helper", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Deleted Global Helper", map[string]any{"id": data.Id.ValueString()})
+}
+
+func (r *globalHelperResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m globalHelperModel) toAPI() client.GlobalHelper {
+	return client.GlobalHelper{
+		ID:          m.Id.ValueString(),
+		Body:        m.Body.ValueString(),
+		Description: m.Description.ValueString(),
+	}
+}
+
+func (m *globalHelperModel) set(helper client.GlobalHelper) {
+	m.Id = types.StringValue(helper.ID)
+	m.Body = types.StringValue(helper.Body)
+	m.Description = types.StringValue(helper.Description)
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestGlobalHelperResource covers create, import and update, with a rule that imports
+// the helper and depends on it.
+func TestGlobalHelperResource(t *testing.T) {
+	suffix := strings.ReplaceAll(uuid.NewString(), "-", "")
+	id := "test_helpers_" + suffix
+	config := func(body string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "panther_global_helper" "test" {
+  id   = %[1]q
+  body = %[2]q
+}
+
+resource "panther_rule" "test" {
+  id         = "Test.UsesHelper.%[3]s"
+  severity   = "LOW"
+  log_types  = ["AWS.CloudTrail"]
+  body       = "import %[1]s\n\ndef rule(event):\n    return %[1]s.is_root(event)\n"
+  depends_on = [panther_global_helper.test]
+}
+`, id, body, suffix)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("def is_root(event):\n    return event.get('userIdentity', {}).get('type') == 'Root'\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_global_helper.test", "id", id),
+					resource.TestCheckResourceAttr("panther_global_helper.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:      "panther_global_helper.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config("def is_root(event):\n    return event.deep_get('userIdentity', 'type') == 'Root'\n"),
+				Check:  resource.TestMatchResourceAttr("panther_global_helper.test", "body", regexp.MustCompile(`deep_get`)),
+			},
+		},
+	})
+}
+
+func TestGlobalHelperResource_InvalidID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "panther_global_helper" "test" {
+  id   = "ip-helpers"
+  body = "def x():\n    pass\n"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be a valid Python module name`),
+			},
+		},
+	})
+}
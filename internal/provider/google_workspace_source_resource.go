@@ -0,0 +1,99 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const googleWorkspaceSourcePath = "/log-sources/google-workspace"
+
+var (
+	googleCustomerIDRegex = regexp.MustCompile(`^C[0-9a-z]{6,}$`)
+)
+
+func NewGoogleWorkspaceSourceResource() resource.Resource {
+	return &saasSourceResource[googleWorkspaceSourceModel, client.GoogleWorkspaceSource, *googleWorkspaceSourceModel]{
+		typeName:   "_google_workspace_source",
+		name:       "Google Workspace Source",
+		path:       googleWorkspaceSourcePath,
+		credential: "service_account_key",
+		schema:     googleWorkspaceSourceSchema,
+	}
+}
+
+// googleWorkspaceSourceModel adds the Google Workspace fields to saasSourceModel.
+// The *Wo fields are only ever set in config; see secretValue.
+type googleWorkspaceSourceModel struct {
+	saasSourceModel
+	CustomerId                 types.String `tfsdk:"customer_id"`
+	AdminEmail                 types.String `tfsdk:"admin_email"`
+	ServiceAccountKey          jsonValue    `tfsdk:"service_account_key"`
+	ServiceAccountKeyWo        types.String `tfsdk:"service_account_key_wo"`
+	ServiceAccountKeyWoVersion types.Int64  `tfsdk:"service_account_key_wo_version"`
+}
+
+func googleWorkspaceSourceSchema() schema.Schema {
+	attributes := saasSourceAttributes()
+	attributes["customer_id"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The customer ID of the Google Workspace account (Admin console > Account settings)",
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(googleCustomerIDRegex, "must be a Google Workspace customer ID, e.g. C03az79cb"),
+		},
+	}
+	attributes["admin_email"] = schema.StringAttribute{
+		Required:    true,
+		Description: "A super administrator the service account impersonates to read the reports",
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(emailRegex, "must be an email address"),
+		},
+	}
+	serviceAccountKey := credentialAttribute("The JSON key of a service account with domain-wide delegation for the admin.reports.audit.readonly scope.")
+	serviceAccountKey.CustomType = jsonType{}
+	attributes["service_account_key"] = serviceAccountKey
+	return schema.Schema{
+		MarkdownDescription: "Manages a Google Workspace log source that pulls Admin SDK activity reports.",
+		Attributes:          attributes,
+	}
+}
+
+func (m googleWorkspaceSourceModel) toAPI(ctx context.Context, config tfsdk.Config, diagnostics *diag.Diagnostics) client.GoogleWorkspaceSource {
+	return client.GoogleWorkspaceSource{
+		SaasSourceCore:    m.toCore(),
+		CustomerId:        m.CustomerId.ValueString(),
+		AdminEmail:        m.AdminEmail.ValueString(),
+		ServiceAccountKey: secretValue(ctx, config, "service_account_key", m.ServiceAccountKey.StringValue, diagnostics),
+	}
+}
+
+func (m *googleWorkspaceSourceModel) set(ctx context.Context, source client.GoogleWorkspaceSource, diagnostics *diag.Diagnostics) {
+	m.setCore(ctx, source.SaasSourceCore, diagnostics)
+	m.CustomerId = types.StringValue(source.CustomerId)
+	m.AdminEmail = types.StringValue(source.AdminEmail)
+}
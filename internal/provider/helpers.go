@@ -20,18 +20,26 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
+	"unicode"
 
 	"terraform-provider-panther/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -39,14 +47,28 @@ import (
 // restClient extracts the *client.RESTClient from the Terraform provider data.
 // Returns nil if provider data is not yet available (during early lifecycle).
 func restClient(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *client.RESTClient {
-	if req.ProviderData == nil {
+	return restClientFromProviderData(req.ProviderData, "Resource", &resp.Diagnostics)
+}
+
+// dataSourceRESTClient is restClient for data sources.
+func dataSourceRESTClient(req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *client.RESTClient {
+	return restClientFromProviderData(req.ProviderData, "Data Source", &resp.Diagnostics)
+}
+
+// ephemeralRESTClient is restClient for ephemeral resources.
+func ephemeralRESTClient(req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) *client.RESTClient {
+	return restClientFromProviderData(req.ProviderData, "Ephemeral Resource", &resp.Diagnostics)
+}
+
+func restClientFromProviderData(providerData any, kind string, diagnostics *diag.Diagnostics) *client.RESTClient {
+	if providerData == nil {
 		return nil
 	}
-	c, ok := req.ProviderData.(*client.RESTClient)
+	c, ok := providerData.(*client.RESTClient)
 	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.RESTClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		diagnostics.AddError(
+			fmt.Sprintf("Unexpected %s Configure Type", kind),
+			fmt.Sprintf("Expected *client.RESTClient, got: %T. Please report this issue to the provider developers.", providerData),
 		)
 		return nil
 	}
@@ -63,16 +85,90 @@ func addAuthDiagnostic(diagnostics *diag.Diagnostics, err error) bool {
 		return true
 	}
 	if client.IsForbidden(err) {
+		guidance := "Your API token may not have permission to manage this resource. " +
+			"Check the token's role and permissions in the Panther console."
+		if missing := client.MissingPermissions(err); len(missing) > 0 {
+			noun := "permission"
+			if len(missing) > 1 {
+				noun = "permissions"
+			}
+			guidance = fmt.Sprintf("Your API token lacks the %s %s. Add it to the token's permissions "+
+				"(panther_api_token) in Terraform or the Panther console.", strings.Join(missing, ", "), noun)
+		}
 		diagnostics.AddError(
 			"Insufficient permissions",
-			"The API returned 403 Forbidden. Your API token may not have permission to manage this resource. "+
-				"Check the token's role and permissions in the Panther console.\n\nAPI error: "+err.Error(),
+			"The API returned 403 Forbidden. "+guidance+"\n\nAPI error: "+err.Error(),
 		)
 		return true
 	}
 	return false
 }
 
+// credentialHints says what to check, per integration, when a third party refuses the
+// credentials Panther was configured with. Keys are the resourceName passed to
+// handleCreateError and handleUpdateError.
+var credentialHints = map[string]string{
+	"Okta Source": "Okta API tokens act with the permissions of the admin who created them and expire after " +
+		"30 days without use. Create one as an admin with at least the Read-Only Administrator role in the org at `okta_domain`.",
+	"GitHub Source": "The token must belong to an owner of `organization`: a classic token needs the " +
+		"`read:audit_log` scope, a fine-grained one the organization's Administration (read) permission. " +
+		"Check that it hasn't expired and that the organization allows access by personal access tokens.",
+	"Slack Source": "The Audit Logs API needs a user token (`xoxp-`) with the `auditlogs:read` scope, from an app " +
+		"installed on the Enterprise Grid organization `enterprise_id` by an Owner, not on a single workspace.",
+	"Google Workspace Source": "The service account needs domain-wide delegation for the " +
+		"`https://www.googleapis.com/auth/admin.reports.audit.readonly` scope, `admin_email` must be a super " +
+		"administrator it can impersonate, and the Admin SDK API must be enabled in its project.",
+	"1Password Source": "Events API tokens belong to one Events Reporting integration and only work against " +
+		"their account's region. Check that the token hasn't expired or been revoked and that `events_api_host` " +
+		"matches the account.",
+	"Microsoft 365 Source": "The secret must be current for app registration `client_id` in tenant `tenant_id`, " +
+		"and the app needs the ActivityFeed.Read application permission of the Office 365 Management APIs, " +
+		"with admin consent.",
+	"Azure Blob Storage Source": "The secret must be current for app registration `client_id` in tenant " +
+		"`tenant_id`, and the app needs the Storage Blob Data Reader role on the storage account.",
+	"Azure Event Hub Source": "The secret must be current for app registration `client_id` in tenant " +
+		"`tenant_id`, and the app needs the Azure Event Hubs Data Receiver role on the event hub.",
+}
+
+// addCredentialDiagnostic reports a 400 saying the third party behind an integration
+// refused some of its credentials, naming the attributes and what to check.
+func addCredentialDiagnostic(diagnostics *diag.Diagnostics, resourceName string, err error) bool {
+	rejected := client.RejectedCredentials(err)
+	if len(rejected) == 0 {
+		return false
+	}
+	attributes := make([]string, len(rejected))
+	for i, field := range rejected {
+		attributes[i] = "`" + camelToSnake(field) + "`"
+	}
+	hint, ok := credentialHints[resourceName]
+	if !ok {
+		hint = "Check that they are current and grant the access the integration needs."
+	}
+	diagnostics.AddError(
+		fmt.Sprintf("%s credentials rejected", resourceName),
+		fmt.Sprintf("The third party refused the configured %s. %s\n\nAPI error: %s",
+			strings.Join(attributes, ", "), hint, err.Error()),
+	)
+	return true
+}
+
+// camelToSnake turns an API field name into the attribute name the code generator
+// derives from it (clientSecret → client_secret).
+func camelToSnake(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
 // handleReadError returns true if the error was handled (caller should return).
 // 404 removes the resource from state (drift detection).
 func handleReadError(ctx context.Context, resp *resource.ReadResponse, resourceName, id string, err error) bool {
@@ -100,7 +196,7 @@ func handleCreateError(resp *resource.CreateResponse, resourceName string, err e
 	if err == nil {
 		return false
 	}
-	if addAuthDiagnostic(&resp.Diagnostics, err) {
+	if addAuthDiagnostic(&resp.Diagnostics, err) || addCredentialDiagnostic(&resp.Diagnostics, resourceName, err) {
 		return true
 	}
 	if client.IsConflict(err) {
@@ -123,7 +219,7 @@ func handleUpdateError(ctx context.Context, resp *resource.UpdateResponse, resou
 	if err == nil {
 		return false
 	}
-	if addAuthDiagnostic(&resp.Diagnostics, err) {
+	if addAuthDiagnostic(&resp.Diagnostics, err) || addCredentialDiagnostic(&resp.Diagnostics, resourceName, err) {
 		return true
 	}
 	// 404 means the resource was deleted out-of-band; remove from state and
@@ -170,6 +266,92 @@ func handleDeleteError(resp *resource.DeleteResponse, resourceName, id string, e
 	return true
 }
 
+// handleDataSourceReadError returns true if the error was handled (caller should return).
+// Unlike handleReadError, 404 is an error: a data source must resolve to an existing object.
+func handleDataSourceReadError(diagnostics *diag.Diagnostics, resourceName, lookup string, err error) bool {
+	if err == nil {
+		return false
+	}
+	if addAuthDiagnostic(diagnostics, err) {
+		return true
+	}
+	if client.IsNotFound(err) {
+		diagnostics.AddError(
+			fmt.Sprintf("%s not found", resourceName),
+			fmt.Sprintf("No %s matches %s.\n\nAPI error: %s", resourceName, lookup, err.Error()),
+		)
+		return true
+	}
+	diagnostics.AddError(
+		fmt.Sprintf("Error reading %s", resourceName),
+		fmt.Sprintf("Could not read %s (%s): %s", resourceName, lookup, err.Error()),
+	)
+	return true
+}
+
+// logStreamTypeOptionsValidator checks log_stream_type_options against log_stream_type for
+// every log source resource. JsonArray and XML need their option configured; an explicit ""
+// keeps its documented meaning (the input is a bare array / has no root element). The other
+// option, and both options for Lines, JSON and CloudWatchLogs, must be left empty. Auto is
+// not checked.
+type logStreamTypeOptionsValidator struct{}
+
+// logStreamTypeRequiredOption maps each checked log_stream_type to the option it requires ("" for none).
+var logStreamTypeRequiredOption = map[string]string{
+	"JsonArray":      "json_array_envelope_field",
+	"XML":            "xml_root_element",
+	"Lines":          "",
+	"JSON":           "",
+	"CloudWatchLogs": "",
+}
+
+var logStreamTypeOptionEmptyMeaning = map[string]string{
+	"json_array_envelope_field": "the input JSON is an array itself",
+	"xml_root_element":          "the XML events are not enclosed in a root element",
+}
+
+func (v logStreamTypeOptionsValidator) Description(_ context.Context) string {
+	return "log_stream_type_options must match log_stream_type"
+}
+
+func (v logStreamTypeOptionsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v logStreamTypeOptionsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var streamType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("log_stream_type"), &streamType)...)
+	if resp.Diagnostics.HasError() || streamType.IsNull() || streamType.IsUnknown() {
+		return
+	}
+	required, ok := logStreamTypeRequiredOption[streamType.ValueString()]
+	if !ok {
+		return
+	}
+
+	for _, field := range []string{"json_array_envelope_field", "xml_root_element"} {
+		fieldPath := path.Root("log_stream_type_options").AtName(field)
+		var value types.String
+		if diags := req.Config.GetAttribute(ctx, fieldPath, &value); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if field == required {
+			if value.IsNull() {
+				resp.Diagnostics.AddAttributeError(fieldPath, "Missing Attribute Configuration",
+					fmt.Sprintf("log_stream_type %q requires log_stream_type_options.%s. Set it to \"\" if %s.",
+						streamType.ValueString(), field, logStreamTypeOptionEmptyMeaning[field]))
+			}
+			continue
+		}
+		if !value.IsUnknown() && value.ValueString() != "" {
+			resp.Diagnostics.AddAttributeError(fieldPath, "Invalid Attribute Combination",
+				fmt.Sprintf("log_stream_type_options.%s is not used by log_stream_type %q. Remove it.",
+					field, streamType.ValueString()))
+		}
+	}
+}
+
 // SchemaOverride describes a patch to apply to a generated string schema attribute.
 // Only non-zero/non-nil fields are applied, so omitted fields leave the attribute unchanged.
 type SchemaOverride struct {
@@ -177,10 +359,12 @@ type SchemaOverride struct {
 	Default       defaults.String       // if non-nil, sets the attribute's Default
 	Sensitive     bool                  // if true, marks the attribute as sensitive
 	PlanModifiers []planmodifier.String // if non-empty, appended to existing plan modifiers
+	Validators    []validator.String    // if non-empty, appended to existing validators
+	WriteOnly     bool                  // if true, adds <Name>_wo and <Name>_wo_version; see addWriteOnlyVariant
 }
 
 // applySchemaOverrides patches generated string attributes that the code generator can't
-// fully configure (defaults, sensitivity, plan modifiers). Attributes that don't exist
+// fully configure (defaults, sensitivity, plan modifiers, validators). Attributes that don't exist
 // or aren't StringAttributes are silently skipped.
 func applySchemaOverrides(s *schema.Schema, overrides []SchemaOverride) {
 	for _, o := range overrides {
@@ -201,10 +385,59 @@ func applySchemaOverrides(s *schema.Schema, overrides []SchemaOverride) {
 		if len(o.PlanModifiers) > 0 {
 			attr.PlanModifiers = append(attr.PlanModifiers, o.PlanModifiers...)
 		}
+		if len(o.Validators) > 0 {
+			attr.Validators = append(attr.Validators, o.Validators...)
+		}
 		s.Attributes[o.Name] = attr
+		if o.WriteOnly {
+			addWriteOnlyVariant(s, o.Name, attr)
+		}
+	}
+}
+
+// addWriteOnlyVariant adds a Terraform 1.11+ write-only alternative to a secret
+// attribute: <name>_wo is sent to the API but never persisted to plan or state,
+// and <name>_wo_version is a plain value the user bumps to push a new secret
+// (a write-only attribute can't produce a diff on its own).
+func addWriteOnlyVariant(s *schema.Schema, name string, secret schema.StringAttribute) {
+	woName, versionName := name+"_wo", name+"_wo_version"
+	s.Attributes[woName] = schema.StringAttribute{
+		Optional:  true,
+		Sensitive: true,
+		WriteOnly: true,
+		Description: fmt.Sprintf("Write-only alternative to %s that is never stored in state. "+
+			"Requires Terraform 1.11 or later. Set %s alongside it. %s", name, versionName, secret.Description),
+		MarkdownDescription: fmt.Sprintf("Write-only alternative to `%s` that is never stored in state. "+
+			"Requires Terraform 1.11 or later. Set `%s` alongside it. %s", name, versionName, secret.Description),
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot(name)),
+			stringvalidator.AlsoRequires(path.MatchRoot(versionName)),
+		},
+	}
+	s.Attributes[versionName] = schema.Int64Attribute{
+		Optional: true,
+		Description: fmt.Sprintf("Version of %s. Terraform can't detect changes to a write-only value, "+
+			"so increment this whenever the secret is rotated to send the new one to Panther.", woName),
+		MarkdownDescription: fmt.Sprintf("Version of `%s`. Terraform can't detect changes to a write-only value, "+
+			"so increment this whenever the secret is rotated to send the new one to Panther.", woName),
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot(woName)),
+		},
 	}
 }
 
+// secretValue returns the secret to send to the API for an attribute added with
+// SchemaOverride.WriteOnly: the <name>_wo value from config when it is set,
+// otherwise the state-stored value from the plan.
+func secretValue(ctx context.Context, config tfsdk.Config, name string, stored types.String, diagnostics *diag.Diagnostics) string {
+	var wo types.String
+	diagnostics.Append(config.GetAttribute(ctx, path.Root(name+"_wo"), &wo)...)
+	if !wo.IsNull() && !wo.IsUnknown() {
+		return wo.ValueString()
+	}
+	return stored.ValueString()
+}
+
 func listToStringSlice(ctx context.Context, list types.List, diagnostics *diag.Diagnostics) []string {
 	var result []string
 	diagnostics.Append(list.ElementsAs(ctx, &result, false)...)
@@ -290,3 +523,38 @@ func (compilesAsRegex) ValidateString(_ context.Context, req validator.StringReq
 		)
 	}
 }
+
+// AWS identifiers checked at plan time wherever Panther is pointed at AWS resources and
+// the IAM role it assumes to reach them (the CloudWatch and EventBridge sources,
+// panther_lookup_table and panther_aws_cloud_account).
+var (
+	awsAccountIDRegex = regexp.MustCompile(`^\d{12}$`)
+	awsRegionRegex    = regexp.MustCompile(`^[a-z]{2}(?:-gov)?-[a-z]+-\d+$`)
+	iamRoleARNRegex   = regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:role/.+$`)
+	kmsKeyARNRegex    = regexp.MustCompile(`^arn:aws[a-z-]*:kms:[a-z]{2}(?:-gov)?-[a-z]+-\d+:\d{12}:(?:key|alias)/.+$`)
+)
+
+func awsAccountIDValidator() validator.String {
+	return stringvalidator.RegexMatches(awsAccountIDRegex, "must be a 12-digit AWS account ID")
+}
+
+func awsRegionValidator() validator.String {
+	return stringvalidator.RegexMatches(awsRegionRegex,
+		"must be a valid AWS region code (e.g. us-east-1, us-gov-west-1)")
+}
+
+// iamRoleARNValidator checks an IAM role ARN; exampleRole names the role in the message.
+func iamRoleARNValidator(exampleRole string) validator.String {
+	return stringvalidator.RegexMatches(iamRoleARNRegex,
+		fmt.Sprintf("must be a valid IAM role ARN (e.g. arn:aws:iam::123456789012:role/%s)", exampleRole))
+}
+
+// kmsKeyARNValidator accepts a KMS key or alias ARN, or "", the default meaning no KMS key.
+func kmsKeyARNValidator() validator.String {
+	return stringvalidator.Any(
+		stringvalidator.LengthAtMost(0),
+		stringvalidator.RegexMatches(kmsKeyARNRegex,
+			"must be a valid KMS key or alias ARN (e.g. arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab "+
+				"or arn:aws:kms:us-east-1:123456789012:alias/panther)"),
+	)
+}
@@ -25,12 +25,14 @@ import (
 	"terraform-provider-panther/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -61,6 +63,17 @@ func TestRestClient_WrongType(t *testing.T) {
 	assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "Unexpected Resource Configure Type")
 }
 
+func TestDataSourceRESTClient_WrongType(t *testing.T) {
+	req := datasource.ConfigureRequest{ProviderData: "wrong-type"}
+	resp := &datasource.ConfigureResponse{}
+
+	c := dataSourceRESTClient(req, resp)
+
+	assert.Nil(t, c)
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "Unexpected Data Source Configure Type")
+}
+
 func TestHandleReadError(t *testing.T) {
 	tests := []struct {
 		name                string
@@ -127,6 +140,12 @@ func TestHandleCreateError(t *testing.T) {
 		{"Conflict",
 			&client.APIError{StatusCode: http.StatusConflict, Message: "label already exists"},
 			true, true, "already exists", "terraform import"},
+		{"ForbiddenMissingPermission",
+			&client.APIError{StatusCode: http.StatusForbidden, Message: "forbidden", MissingPermissions: []string{"RuleModify"}},
+			true, true, "Insufficient permissions", "lacks the RuleModify permission"},
+		{"RejectedCredentials",
+			&client.APIError{StatusCode: http.StatusBadRequest, Message: "refused", RejectedCredentials: []string{"apiToken"}},
+			true, true, "Test credentials rejected", "refused the configured `api_token`"},
 		{"OtherError",
 			fmt.Errorf("connection refused"),
 			true, true, "Error creating Test", ""},
@@ -171,6 +190,9 @@ func TestHandleUpdateError(t *testing.T) {
 		{"Conflict",
 			&client.APIError{StatusCode: http.StatusConflict, Message: "label already exists"},
 			false, true, true, false, "Conflict updating Test", "conflicts with an existing resource"},
+		{"RejectedCredentials",
+			&client.APIError{StatusCode: http.StatusBadRequest, Message: "refused", RejectedCredentials: []string{"clientSecret"}},
+			false, true, true, false, "Test credentials rejected", "refused the configured `client_secret`"},
 		{"OtherError",
 			fmt.Errorf("connection refused"),
 			false, true, true, false, "Error updating Test", ""},
@@ -210,6 +232,16 @@ func TestHandleUpdateError(t *testing.T) {
 	}
 }
 
+func TestAddCredentialDiagnostic_IntegrationHint(t *testing.T) {
+	err := &client.APIError{StatusCode: http.StatusBadRequest, Message: "refused", RejectedCredentials: []string{"apiToken"}}
+	var diagnostics diag.Diagnostics
+	assert.True(t, addCredentialDiagnostic(&diagnostics, "Okta Source", err))
+	assert.Contains(t, diagnostics.Errors()[0].Detail(), "Read-Only Administrator")
+
+	assert.False(t, addCredentialDiagnostic(&diagnostics, "Okta Source",
+		&client.APIError{StatusCode: http.StatusBadRequest, Message: "domain is required"}))
+}
+
 func TestHandleDeleteError(t *testing.T) {
 	tests := []struct {
 		name                string
@@ -239,6 +271,37 @@ func TestHandleDeleteError(t *testing.T) {
 	}
 }
 
+func TestHandleDataSourceReadError(t *testing.T) {
+	tests := []struct {
+		name                string
+		err                 error
+		wantHandled         bool
+		wantSummaryContains string
+	}{
+		{"Nil", nil, false, ""},
+		{"NotFound",
+			&client.APIError{StatusCode: http.StatusNotFound, Message: "not found"},
+			true, "Test not found"},
+		{"Unauthorized",
+			&client.APIError{StatusCode: http.StatusUnauthorized, Message: "unauthorized"},
+			true, "Authentication failed"},
+		{"OtherError",
+			fmt.Errorf("connection refused"),
+			true, "Error reading Test"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			handled := handleDataSourceReadError(&diags, "Test", `id "id-1"`, tt.err)
+			assert.Equal(t, tt.wantHandled, handled)
+			assert.Equal(t, tt.wantHandled, diags.HasError())
+			if tt.wantSummaryContains != "" {
+				assert.Contains(t, diags.Errors()[0].Summary(), tt.wantSummaryContains)
+			}
+		})
+	}
+}
+
 func TestApplySchemaOverrides_Default(t *testing.T) {
 	s := schema.Schema{
 		Attributes: map[string]schema.Attribute{
@@ -279,6 +342,22 @@ func TestApplySchemaOverrides_PlanModifiers(t *testing.T) {
 	assert.Len(t, attr.PlanModifiers, 1)
 }
 
+func TestApplySchemaOverrides_Validators(t *testing.T) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+		},
+	}
+	applySchemaOverrides(&s, []SchemaOverride{
+		{Name: "name", Validators: []validator.String{stringvalidator.LengthAtMost(5)}},
+	})
+	attr := s.Attributes["name"].(schema.StringAttribute)
+	assert.Len(t, attr.Validators, 2, "generated validators are kept")
+}
+
 func TestApplySchemaOverrides_MissingAttribute(t *testing.T) {
 	s := schema.Schema{
 		Attributes: map[string]schema.Attribute{},
@@ -327,6 +406,59 @@ func TestApplySchemaOverrides_MultipleOverrides(t *testing.T) {
 	assert.Len(t, attrB.PlanModifiers, 1)
 }
 
+func TestApplySchemaOverrides_WriteOnly(t *testing.T) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{Optional: true, Computed: true, Description: "The token."},
+		},
+	}
+	applySchemaOverrides(&s, []SchemaOverride{
+		{Name: "token", Default: stringdefault.StaticString(""), Sensitive: true, WriteOnly: true},
+	})
+
+	wo, ok := s.Attributes["token_wo"].(schema.StringAttribute)
+	require.True(t, ok, "token_wo should be a StringAttribute, got %T", s.Attributes["token_wo"])
+	assert.True(t, wo.WriteOnly)
+	assert.True(t, wo.Sensitive)
+	assert.False(t, wo.Computed, "write-only attributes can't be computed")
+	assert.Nil(t, wo.Default, "write-only attributes can't have a default")
+	assert.Contains(t, wo.Description, "The token.")
+	assert.Len(t, wo.Validators, 2)
+
+	version, ok := s.Attributes["token_wo_version"].(schema.Int64Attribute)
+	require.True(t, ok, "token_wo_version should be an Int64Attribute, got %T", s.Attributes["token_wo_version"])
+	assert.True(t, version.Optional)
+	assert.False(t, version.WriteOnly, "the version must be stored so changing it produces a diff")
+
+	secret := s.Attributes["token"].(schema.StringAttribute)
+	assert.False(t, secret.WriteOnly, "the state-stored attribute is kept for existing configs")
+}
+
+func TestSecretValue(t *testing.T) {
+	ctx := context.Background()
+	configSchema := schema.Schema{Attributes: map[string]schema.Attribute{
+		"token":    schema.StringAttribute{Optional: true},
+		"token_wo": schema.StringAttribute{Optional: true, WriteOnly: true},
+	}}
+	configWith := func(wo tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: configSchema,
+			Raw: tftypes.NewValue(
+				tftypes.Object{AttributeTypes: map[string]tftypes.Type{"token": tftypes.String, "token_wo": tftypes.String}},
+				map[string]tftypes.Value{"token": tftypes.NewValue(tftypes.String, nil), "token_wo": wo},
+			),
+		}
+	}
+
+	var diags diag.Diagnostics
+	got := secretValue(ctx, configWith(tftypes.NewValue(tftypes.String, "from-config")), "token", types.StringValue("from-state"), &diags)
+	assert.Equal(t, "from-config", got)
+
+	got = secretValue(ctx, configWith(tftypes.NewValue(tftypes.String, nil)), "token", types.StringValue("from-state"), &diags)
+	assert.Equal(t, "from-state", got)
+	assert.False(t, diags.HasError())
+}
+
 // assertNoOptionalComputedWithoutDefault checks that every Optional+Computed string attribute
 // in the schema has a Default set. Call this in tests after Schema() to catch missing overrides
 // when the generated schema adds new fields.
@@ -437,6 +569,61 @@ func TestPubsubsourceSchema_AllOptionalComputedHaveDefaults(t *testing.T) {
 	assertNoOptionalComputedWithoutDefault(t, resp.Schema)
 }
 
+func TestCloudWatchSourceSchema_AllOptionalComputedHaveDefaults(t *testing.T) {
+	r := &cloudWatchSourceResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), req, resp)
+	assertNoOptionalComputedWithoutDefault(t, resp.Schema)
+}
+
+func TestEventBridgeSourceSchema_AllOptionalComputedHaveDefaults(t *testing.T) {
+	r := &eventBridgeSourceResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), req, resp)
+	assertNoOptionalComputedWithoutDefault(t, resp.Schema)
+}
+
+func TestAzureBlobSourceSchema_AllOptionalComputedHaveDefaults(t *testing.T) {
+	r := &azureBlobSourceResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), req, resp)
+	assertNoOptionalComputedWithoutDefault(t, resp.Schema)
+}
+
+func TestAzureEventHubSourceSchema_AllOptionalComputedHaveDefaults(t *testing.T) {
+	r := &azureEventHubSourceResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), req, resp)
+	assertNoOptionalComputedWithoutDefault(t, resp.Schema)
+}
+
+func TestSaasSourceSchemas_AllOptionalComputedHaveDefaults(t *testing.T) {
+	for _, r := range []resource.Resource{
+		NewOktaSourceResource(),
+		NewGitHubSourceResource(),
+		NewSlackSourceResource(),
+		NewGoogleWorkspaceSourceResource(),
+		NewOnePasswordSourceResource(),
+		NewMicrosoft365SourceResource(),
+	} {
+		resp := &resource.SchemaResponse{}
+		r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+		assertNoOptionalComputedWithoutDefault(t, resp.Schema)
+	}
+}
+
+func TestSqsSourceSchema_AllOptionalComputedHaveDefaults(t *testing.T) {
+	r := &sqsSourceResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), req, resp)
+	assertNoOptionalComputedWithoutDefault(t, resp.Schema)
+}
+
 func TestGcssourceSchema_AllOptionalComputedHaveDefaults(t *testing.T) {
 	r := &gcssourceResource{}
 	req := resource.SchemaRequest{}
@@ -0,0 +1,136 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"maps"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                     = (*httpsourceDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*httpsourceDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*httpsourceDataSource)(nil)
+)
+
+func NewHttpsourceDataSource() datasource.DataSource {
+	return &httpsourceDataSource{}
+}
+
+type httpsourceDataSource struct {
+	rest *client.RESTClient
+}
+
+// httpsourceDataSourceModel omits auth_password, auth_secret_value and
+// auth_bearer_token: the API never returns them.
+type httpsourceDataSourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	IntegrationLabel     types.String `tfsdk:"integration_label"`
+	LogStreamType        types.String `tfsdk:"log_stream_type"`
+	LogStreamTypeOptions types.Object `tfsdk:"log_stream_type_options"`
+	LogTypes             types.List   `tfsdk:"log_types"`
+	AuthMethod           types.String `tfsdk:"auth_method"`
+	AuthHmacAlg          types.String `tfsdk:"auth_hmac_alg"`
+	AuthHeaderKey        types.String `tfsdk:"auth_header_key"`
+	AuthUsername         types.String `tfsdk:"auth_username"`
+}
+
+func (d *httpsourceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_httpsource"
+}
+
+func (d *httpsourceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"log_stream_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The log stream type.",
+		},
+		"log_stream_type_options": logStreamTypeOptionsDataSourceAttribute(),
+		"log_types": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "The log types of the integration.",
+		},
+		"auth_method": schema.StringAttribute{
+			Computed:    true,
+			Description: "The authentication method of the http source.",
+		},
+		"auth_hmac_alg": schema.StringAttribute{
+			Computed:    true,
+			Description: "The authentication algorithm of the http source. Used for HMAC auth method.",
+		},
+		"auth_header_key": schema.StringAttribute{
+			Computed:    true,
+			Description: "The authentication header key of the http source. Used for HMAC and SharedSecret auth methods.",
+		},
+		"auth_username": schema.StringAttribute{
+			Computed:    true,
+			Description: "The authentication header username of the http source. Used for Basic auth method.",
+		},
+	}
+	maps.Copy(attributes, logSourceLookupAttributes("HTTP log source", "integration_label"))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing HTTP Log Source in Panther by `id` or `integration_label`. " +
+			"Authentication secrets are never returned by the API and are not exposed.",
+		Attributes: attributes,
+	}
+}
+
+func (d *httpsourceDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return logSourceLookupValidators("integration_label")
+}
+
+func (d *httpsourceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.rest = dataSourceRESTClient(req, resp)
+}
+
+func (d *httpsourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data httpsourceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpSource, ok := lookupLogSource(ctx, d.rest, httpSourcePath, "HTTP Source", "integration_label", data.Id, data.IntegrationLabel,
+		func(s client.HttpSource) string { return s.IntegrationLabel }, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	tflog.Debug(ctx, "Read HTTP Source data source", map[string]any{"id": httpSource.IntegrationId})
+
+	data.Id = types.StringValue(httpSource.IntegrationId)
+	data.IntegrationLabel = types.StringValue(httpSource.IntegrationLabel)
+	data.LogStreamType = types.StringValue(httpSource.LogStreamType)
+	data.LogTypes = stringSliceToList(ctx, httpSource.LogTypes, &resp.Diagnostics)
+	data.AuthMethod = types.StringValue(httpSource.AuthMethod)
+	data.AuthHmacAlg = types.StringValue(httpSource.AuthHmacAlg)
+	data.AuthHeaderKey = types.StringValue(httpSource.AuthHeaderKey)
+	data.AuthUsername = types.StringValue(httpSource.AuthUsername)
+	data.LogStreamTypeOptions = types.ObjectNull(logStreamTypeOptionAttrTypes)
+	if opts := httpSource.LogStreamTypeOptions; opts != nil {
+		data.LogStreamTypeOptions = logStreamTypeOptionsToObject(opts.JsonArrayEnvelopeField, opts.XmlRootElement)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestHttpsourceDataSource looks up an HTTP source created in the same config, once by
+// id and once by integration_label. Like TestHttpSourceResource, the last step deletes
+// the source out-of-band because its Firehose can't be torn down right after creation.
+func TestHttpsourceDataSource(t *testing.T) {
+	integrationLabel := strings.ReplaceAll(uuid.NewString(), "-", "")
+	lookups := `
+data "panther_httpsource" "by_id" {
+  id = panther_httpsource.test.id
+}
+
+data "panther_httpsource" "by_label" {
+  integration_label = panther_httpsource.test.integration_label
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testUpdatedHttpSourceResourceConfig(integrationLabel) + lookups,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.panther_httpsource.by_id", "integration_label", "panther_httpsource.test", "integration_label"),
+					resource.TestCheckResourceAttrPair("data.panther_httpsource.by_label", "id", "panther_httpsource.test", "id"),
+					resource.TestCheckResourceAttr("data.panther_httpsource.by_label", "log_stream_type", "JsonArray"),
+					resource.TestCheckResourceAttr("data.panther_httpsource.by_label", "log_types.0", "Zscaler.ZIA.WebLog"),
+					resource.TestCheckResourceAttr("data.panther_httpsource.by_label", "auth_method", "Basic"),
+					resource.TestCheckResourceAttr("data.panther_httpsource.by_label", "auth_username", "foo"),
+					resource.TestCheckResourceAttr("data.panther_httpsource.by_label", "log_stream_type_options.json_array_envelope_field", "records"),
+					resource.TestCheckNoResourceAttr("data.panther_httpsource.by_label", "auth_password"),
+				),
+			},
+			{
+				Config:             providerConfig + testUpdatedHttpSourceResourceConfig(integrationLabel),
+				Check:              manuallyDeleteSource(t, "panther_httpsource.test", httpSourcePath),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestHttpsourceDataSource_NotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "panther_httpsource" "test" {
+  integration_label = "tf-acc-no-such-http-source"
+}
+`,
+				ExpectError: regexp.MustCompile(`HTTP Source not found`),
+			},
+			{
+				Config: providerConfig + `
+data "panther_httpsource" "test" {
+  id = "00000000-0000-0000-0000-000000000000"
+}
+`,
+				ExpectError: regexp.MustCompile(`HTTP Source not found`),
+			},
+		},
+	})
+}
+
+// TestHttpsourceDataSource_LookupValidation covers the ExactlyOneOf(id, label) rule
+// shared by every log source data source. No API call.
+func TestHttpsourceDataSource_LookupValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "panther_httpsource" "test" {
+  id                = "00000000-0000-0000-0000-000000000000"
+  integration_label = "both"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: providerConfig + `
+data "panther_httpsource" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`Missing Attribute Configuration`),
+			},
+		},
+	})
+}
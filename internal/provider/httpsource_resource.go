@@ -18,11 +18,19 @@ package provider
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"slices"
 	"terraform-provider-panther/internal/client"
 	"terraform-provider-panther/internal/provider/resource_httpsource"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -37,9 +45,11 @@ import (
 const httpSourcePath = "/log-sources/http"
 
 var (
-	_ resource.Resource                = (*httpsourceResource)(nil)
-	_ resource.ResourceWithConfigure   = (*httpsourceResource)(nil)
-	_ resource.ResourceWithImportState = (*httpsourceResource)(nil)
+	_ resource.Resource                     = (*httpsourceResource)(nil)
+	_ resource.ResourceWithConfigure        = (*httpsourceResource)(nil)
+	_ resource.ResourceWithImportState      = (*httpsourceResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*httpsourceResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*httpsourceResource)(nil)
 )
 
 func NewHttpsourceResource() resource.Resource {
@@ -50,6 +60,19 @@ type httpsourceResource struct {
 	rest *client.RESTClient
 }
 
+// httpsourceModel is the generated model plus the write-only variants of its
+// secrets. The *Wo fields are only ever set in config; see secretValue.
+type httpsourceModel struct {
+	resource_httpsource.HttpsourceModel
+	AuthPasswordWo           types.String `tfsdk:"auth_password_wo"`
+	AuthPasswordWoVersion    types.Int64  `tfsdk:"auth_password_wo_version"`
+	AuthSecretValueWo        types.String `tfsdk:"auth_secret_value_wo"`
+	AuthSecretValueWoVersion types.Int64  `tfsdk:"auth_secret_value_wo_version"`
+	AuthBearerTokenWo        types.String `tfsdk:"auth_bearer_token_wo"`
+	AuthBearerTokenWoVersion types.Int64  `tfsdk:"auth_bearer_token_wo_version"`
+	AuthSecretRevision       types.Int64  `tfsdk:"auth_secret_revision"`
+}
+
 func (r *httpsourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_httpsource"
 }
@@ -61,10 +84,10 @@ func (r *httpsourceResource) Schema(ctx context.Context, req resource.SchemaRequ
 		{Name: "id", PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
 		{Name: "auth_hmac_alg", Default: stringdefault.StaticString("")},
 		{Name: "auth_header_key", Default: stringdefault.StaticString("")},
-		{Name: "auth_password", Default: stringdefault.StaticString(""), Sensitive: true},
-		{Name: "auth_secret_value", Default: stringdefault.StaticString(""), Sensitive: true},
+		{Name: "auth_password", Default: stringdefault.StaticString(""), Sensitive: true, WriteOnly: true},
+		{Name: "auth_secret_value", Default: stringdefault.StaticString(""), Sensitive: true, WriteOnly: true},
 		{Name: "auth_username", Default: stringdefault.StaticString("")},
-		{Name: "auth_bearer_token", Default: stringdefault.StaticString(""), Sensitive: true},
+		{Name: "auth_bearer_token", Default: stringdefault.StaticString(""), Sensitive: true, WriteOnly: true},
 	})
 
 	// logStreamTypeOptions: nested object needs inner defaults + null object default
@@ -83,6 +106,26 @@ func (r *httpsourceResource) Schema(ctx context.Context, req resource.SchemaRequ
 	))
 
 	resp.Schema.Attributes["log_stream_type_options"] = logStreamTypeOptions
+
+	resp.Schema.Attributes["auth_secret_revision"] = schema.Int64Attribute{
+		Computed: true,
+		MarkdownDescription: "Incremented each time the auth secrets Terraform sends to Panther change. The provider keeps a " +
+			"salted hash of the secrets it last sent in private state and compares it with the configuration, so a " +
+			"changed `_wo` secret is sent even if its `_wo_version` wasn't bumped. Secrets rotated in the Panther " +
+			"console can't be detected: the API never returns them. After an import the provider has no hash yet, " +
+			"so the next change to a `_wo` secret still needs a `_wo_version` bump.",
+	}
+}
+
+func (r *httpsourceResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{httpAuthMethodValidator{}, logStreamTypeOptionsValidator{}}
+}
+
+func (r *httpsourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateLogTypes(ctx, r.rest, req, resp, logTypesExpression)
+	if !req.Plan.Raw.IsNull() {
+		planAuthSecretRevision(ctx, req, resp)
+	}
 }
 
 func (r *httpsourceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -90,7 +133,7 @@ func (r *httpsourceResource) Configure(_ context.Context, req resource.Configure
 }
 
 func (r *httpsourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data resource_httpsource.HttpsourceModel
+	var data httpsourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -103,11 +146,11 @@ func (r *httpsourceResource) Create(ctx context.Context, req resource.CreateRequ
 		LogStreamTypeOptions: httpLogStreamTypeOptions(data.LogStreamTypeOptions),
 		AuthHmacAlg:          data.AuthHmacAlg.ValueString(),
 		AuthHeaderKey:        data.AuthHeaderKey.ValueString(),
-		AuthPassword:         data.AuthPassword.ValueString(),
-		AuthSecretValue:      data.AuthSecretValue.ValueString(),
+		AuthPassword:         secretValue(ctx, req.Config, "auth_password", data.AuthPassword, &resp.Diagnostics),
+		AuthSecretValue:      secretValue(ctx, req.Config, "auth_secret_value", data.AuthSecretValue, &resp.Diagnostics),
 		AuthMethod:           data.AuthMethod.ValueString(),
 		AuthUsername:         data.AuthUsername.ValueString(),
-		AuthBearerToken:      data.AuthBearerToken.ValueString(),
+		AuthBearerToken:      secretValue(ctx, req.Config, "auth_bearer_token", data.AuthBearerToken, &resp.Diagnostics),
 	}
 
 	httpSource, err := client.RestDo[client.HttpSource](ctx, r.rest, http.MethodPost, httpSourcePath, input)
@@ -117,12 +160,13 @@ func (r *httpsourceResource) Create(ctx context.Context, req resource.CreateRequ
 	tflog.Debug(ctx, "Created HTTP Source", map[string]any{
 		"id": httpSource.IntegrationId,
 	})
+	saveSecretFingerprint(ctx, resp.Private, httpAuthSecretValues(input), &resp.Diagnostics)
 	data.Id = types.StringValue(httpSource.IntegrationId)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *httpsourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data resource_httpsource.HttpsourceModel
+	var data httpsourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -136,7 +180,11 @@ func (r *httpsourceResource) Read(ctx context.Context, req resource.ReadRequest,
 		"id": httpSource.IntegrationId,
 	})
 	// Sensitive fields (auth_password, auth_secret_value, auth_bearer_token) are returned as ""
-	// by the API — don't overwrite state for those.
+	// by the API — don't overwrite state for those. Changes to them on the config side are
+	// caught by planAuthSecretRevision; changes made in the Panther console can't be.
+	if data.AuthSecretRevision.IsNull() {
+		data.AuthSecretRevision = types.Int64Value(0) // imported, or created by an older provider
+	}
 	data.Id = types.StringValue(httpSource.IntegrationId)
 	data.IntegrationLabel = types.StringValue(httpSource.IntegrationLabel)
 	data.LogStreamType = types.StringValue(httpSource.LogStreamType)
@@ -164,7 +212,7 @@ func (r *httpsourceResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *httpsourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data resource_httpsource.HttpsourceModel
+	var data httpsourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -177,11 +225,11 @@ func (r *httpsourceResource) Update(ctx context.Context, req resource.UpdateRequ
 		LogStreamTypeOptions: httpLogStreamTypeOptions(data.LogStreamTypeOptions),
 		AuthHmacAlg:          data.AuthHmacAlg.ValueString(),
 		AuthHeaderKey:        data.AuthHeaderKey.ValueString(),
-		AuthPassword:         data.AuthPassword.ValueString(),
-		AuthSecretValue:      data.AuthSecretValue.ValueString(),
+		AuthPassword:         secretValue(ctx, req.Config, "auth_password", data.AuthPassword, &resp.Diagnostics),
+		AuthSecretValue:      secretValue(ctx, req.Config, "auth_secret_value", data.AuthSecretValue, &resp.Diagnostics),
 		AuthMethod:           data.AuthMethod.ValueString(),
 		AuthUsername:         data.AuthUsername.ValueString(),
-		AuthBearerToken:      data.AuthBearerToken.ValueString(),
+		AuthBearerToken:      secretValue(ctx, req.Config, "auth_bearer_token", data.AuthBearerToken, &resp.Diagnostics),
 	}
 
 	_, err := client.RestDo[client.HttpSource](ctx, r.rest, http.MethodPut, httpSourcePath+"/"+data.Id.ValueString(), input)
@@ -191,12 +239,13 @@ func (r *httpsourceResource) Update(ctx context.Context, req resource.UpdateRequ
 	tflog.Debug(ctx, "Updated HTTP Source", map[string]any{
 		"id": data.Id.ValueString(),
 	})
+	saveSecretFingerprint(ctx, resp.Private, httpAuthSecretValues(input), &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *httpsourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_httpsource.HttpsourceModel
+	var data httpsourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -224,3 +273,159 @@ func httpLogStreamTypeOptions(opts resource_httpsource.LogStreamTypeOptionsValue
 		XmlRootElement:         opts.XmlRootElement.ValueString(),
 	}
 }
+
+// httpAuthFields lists, per auth_method, the auth attributes that must be set.
+// Secrets are satisfied by either the state-stored attribute or its _wo variant.
+var httpAuthFields = map[string][]string{
+	"None":         {},
+	"Basic":        {"auth_username", "auth_password"},
+	"Bearer":       {"auth_bearer_token"},
+	"HMAC":         {"auth_header_key", "auth_hmac_alg", "auth_secret_value"},
+	"SharedSecret": {"auth_header_key", "auth_secret_value"},
+}
+
+var httpAuthSecrets = map[string]bool{
+	"auth_password":     true,
+	"auth_bearer_token": true,
+	"auth_secret_value": true,
+}
+
+// httpAuthMethodValidator rejects configs whose auth_* attributes don't match auth_method:
+// every field the method needs must be set and every other auth field left unset, so
+// mistakes surface at plan time instead of as a 400 from the API. Empty strings count as
+// unset because the attributes default to "".
+type httpAuthMethodValidator struct{}
+
+func (v httpAuthMethodValidator) Description(_ context.Context) string {
+	return "auth_* attributes must match auth_method"
+}
+
+func (v httpAuthMethodValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v httpAuthMethodValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var method types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("auth_method"), &method)...)
+	if resp.Diagnostics.HasError() || method.IsNull() || method.IsUnknown() {
+		return
+	}
+	required, ok := httpAuthFields[method.ValueString()]
+	if !ok {
+		return // rejected by the attribute's OneOf validator
+	}
+
+	isSet := func(name string) bool {
+		var value types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+		return value.IsUnknown() || value.ValueString() != ""
+	}
+
+	for _, name := range required {
+		if isSet(name) || (httpAuthSecrets[name] && isSet(name+"_wo")) {
+			continue
+		}
+		detail := fmt.Sprintf("auth_method %q requires %s to be set.", method.ValueString(), name)
+		if httpAuthSecrets[name] {
+			detail = fmt.Sprintf("auth_method %q requires %s (or %s_wo) to be set.", method.ValueString(), name, name)
+		}
+		resp.Diagnostics.AddAttributeError(path.Root(name), "Missing Attribute Configuration", detail)
+	}
+
+	for _, name := range []string{"auth_username", "auth_password", "auth_bearer_token", "auth_header_key", "auth_hmac_alg", "auth_secret_value"} {
+		if slices.Contains(required, name) {
+			continue
+		}
+		candidates := []string{name}
+		if httpAuthSecrets[name] {
+			candidates = append(candidates, name+"_wo")
+		}
+		for _, candidate := range candidates {
+			if isSet(candidate) {
+				resp.Diagnostics.AddAttributeError(path.Root(candidate), "Invalid Attribute Combination",
+					fmt.Sprintf("%s is not used by auth_method %q. Remove it.", candidate, method.ValueString()))
+			}
+		}
+	}
+}
+
+// httpSecretFingerprintKey is the private state key of the fingerprint of the auth
+// secrets last sent to Panther.
+const httpSecretFingerprintKey = "auth_secret_fingerprint"
+
+// secretFingerprint is a salted HMAC-SHA256 of secrets, kept in private state so a
+// changed write-only secret can be detected without storing it.
+type secretFingerprint struct {
+	Salt []byte `json:"salt"`
+	Hash []byte `json:"hash"`
+}
+
+func newSecretFingerprint(salt []byte, secrets []string) secretFingerprint {
+	mac := hmac.New(sha256.New, salt)
+	for _, secret := range secrets {
+		// Length-prefix each secret so ("ab", "") and ("a", "b") hash differently.
+		mac.Write(binary.BigEndian.AppendUint64(nil, uint64(len(secret))))
+		mac.Write([]byte(secret))
+	}
+	return secretFingerprint{Salt: salt, Hash: mac.Sum(nil)}
+}
+
+// httpAuthSecretValues returns the secrets of an HTTP source request in a fixed order.
+func httpAuthSecretValues(input client.HttpSourceInput) []string {
+	return []string{input.AuthPassword, input.AuthSecretValue, input.AuthBearerToken}
+}
+
+// privateStateSetter is the write side of the framework's private state.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// saveSecretFingerprint records the fingerprint of the secrets just sent, with a fresh salt.
+func saveSecretFingerprint(ctx context.Context, private privateStateSetter, secrets []string, diagnostics *diag.Diagnostics) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		diagnostics.AddError("Error Generating Salt", err.Error())
+		return
+	}
+	value, err := json.Marshal(newSecretFingerprint(salt, secrets))
+	if err != nil {
+		diagnostics.AddError("Error Encoding Secret Fingerprint", err.Error())
+		return
+	}
+	diagnostics.Append(private.SetKey(ctx, httpSecretFingerprintKey, value)...)
+}
+
+// planAuthSecretRevision plans auth_secret_revision: 1 on create, and one more than in
+// state when the configured secrets don't match the fingerprint of the ones last sent.
+// The bump is what makes Terraform plan an update; the fingerprint alone can't, and a
+// write-only secret never shows up in the plan. Secrets that aren't known yet count as
+// changed. Without a fingerprint (after an import) nothing is compared.
+func planAuthSecretRevision(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	revisionPath := path.Root("auth_secret_revision")
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, revisionPath, types.Int64Value(1))...)
+		return
+	}
+	var prior types.Int64
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, revisionPath, &prior)...)
+	revision := prior.ValueInt64()
+
+	stored, d := req.Private.GetKey(ctx, httpSecretFingerprintKey)
+	resp.Diagnostics.Append(d...)
+	var fingerprint secretFingerprint
+	if len(stored) > 0 && json.Unmarshal(stored, &fingerprint) == nil {
+		var secrets []string
+		known := true
+		for _, name := range []string{"auth_password", "auth_secret_value", "auth_bearer_token"} {
+			var planned, wo types.String
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &planned)...)
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name+"_wo"), &wo)...)
+			known = known && !planned.IsUnknown() && !wo.IsUnknown()
+			secrets = append(secrets, secretValue(ctx, req.Config, name, planned, &resp.Diagnostics))
+		}
+		if !known || !hmac.Equal(newSecretFingerprint(fingerprint.Salt, secrets).Hash, fingerprint.Hash) {
+			revision++
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, revisionPath, types.Int64Value(revision))...)
+}
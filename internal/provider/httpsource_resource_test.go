@@ -21,13 +21,16 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"terraform-provider-panther/internal/client"
 )
@@ -52,27 +55,30 @@ func TestHttpSourceResource(t *testing.T) {
 					resource.TestCheckResourceAttr("panther_httpsource.test", "auth_method", "SharedSecret"),
 					resource.TestCheckResourceAttr("panther_httpsource.test", "auth_header_key", "x-api-key"),
 					resource.TestCheckResourceAttr("panther_httpsource.test", "auth_secret_value", "test-secret-value"),
+					resource.TestCheckResourceAttr("panther_httpsource.test", "auth_secret_revision", "1"),
 				),
 			},
-			// ImportState testing
+			// ImportState testing. An imported source has no secret fingerprint, so its
+			// auth_secret_revision starts over at 0.
 			{
 				ResourceName:            "panther_httpsource.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"auth_secret_value", "auth_password", "auth_bearer_token"},
+				ImportStateVerifyIgnore: []string{"auth_secret_value", "auth_password", "auth_bearer_token", "auth_secret_revision"},
 			},
 			// Update and Read testing
 			{
 				Config: providerConfig + testUpdatedHttpSourceResourceConfig(integrationUpdatedLabel),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("panther_httpsource.test", "integration_label", integrationUpdatedLabel),
-					resource.TestCheckResourceAttr("panther_httpsource.test", "log_stream_type", "JSON"),
+					resource.TestCheckResourceAttr("panther_httpsource.test", "log_stream_type", "JsonArray"),
 					resource.TestCheckResourceAttr("panther_httpsource.test", "log_types.0", "Zscaler.ZIA.WebLog"),
 					resource.TestCheckResourceAttr("panther_httpsource.test", "auth_method", "Basic"),
 					resource.TestCheckResourceAttr("panther_httpsource.test", "auth_username", "foo"),
 					resource.TestCheckResourceAttr("panther_httpsource.test", "auth_password", "bar"),
+					resource.TestCheckResourceAttr("panther_httpsource.test", "auth_secret_revision", "2"),
 					resource.TestCheckResourceAttr("panther_httpsource.test", "log_stream_type_options.json_array_envelope_field", "records"),
-					resource.TestCheckResourceAttr("panther_httpsource.test", "log_stream_type_options.xml_root_element", "root"),
+					resource.TestCheckResourceAttr("panther_httpsource.test", "log_stream_type_options.xml_root_element", ""),
 				),
 			},
 			// Drift detection: manually delete the resource, then verify Read detects 404
@@ -87,6 +93,192 @@ func TestHttpSourceResource(t *testing.T) {
 	})
 }
 
+// TestHttpSourceResource_WriteOnlySecret creates a Bearer source from auth_bearer_token_wo
+// and rotates the token by bumping auth_bearer_token_wo_version, then again without bumping
+// it, which the private-state fingerprint catches. No token may reach state.
+func TestHttpSourceResource_WriteOnlySecret(t *testing.T) {
+	integrationLabel := strings.ReplaceAll(uuid.NewString(), "-", "")
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testWriteOnlyHttpSourceResourceConfig(integrationLabel, "token-1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_httpsource.test", "auth_method", "Bearer"),
+					resource.TestCheckResourceAttr("panther_httpsource.test", "auth_bearer_token", ""),
+					resource.TestCheckNoResourceAttr("panther_httpsource.test", "auth_bearer_token_wo"),
+					resource.TestCheckResourceAttr("panther_httpsource.test", "auth_bearer_token_wo_version", "1"),
+					resource.TestCheckResourceAttr("panther_httpsource.test", "auth_secret_revision", "1"),
+				),
+			},
+			{
+				Config: providerConfig + testWriteOnlyHttpSourceResourceConfig(integrationLabel, "token-2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("panther_httpsource.test", "auth_bearer_token_wo"),
+					resource.TestCheckResourceAttr("panther_httpsource.test", "auth_bearer_token_wo_version", "2"),
+					resource.TestCheckResourceAttr("panther_httpsource.test", "auth_secret_revision", "2"),
+				),
+			},
+			{
+				Config: providerConfig + testWriteOnlyHttpSourceResourceConfig(integrationLabel, "token-3", 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("panther_httpsource.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("panther_httpsource.test", "auth_bearer_token_wo"),
+					resource.TestCheckResourceAttr("panther_httpsource.test", "auth_secret_revision", "3"),
+				),
+			},
+			{
+				Config:             providerConfig + testWriteOnlyHttpSourceResourceConfig(integrationLabel, "token-3", 2),
+				Check:              manuallyDeleteSource(t, "panther_httpsource.test", httpSourcePath),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// TestHttpSourceResource_WriteOnlySecretValidation covers the pairing rules between a
+// secret, its _wo variant and the _wo_version trigger. No API call.
+func TestHttpSourceResource_WriteOnlySecretValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "panther_httpsource" "test" {
+  integration_label            = "both-secrets"
+  log_stream_type              = "Auto"
+  log_types                    = ["AWS.CloudFrontAccess"]
+  auth_method                  = "Bearer"
+  auth_bearer_token            = "stored"
+  auth_bearer_token_wo         = "write-only"
+  auth_bearer_token_wo_version = 1
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: providerConfig + `
+resource "panther_httpsource" "test" {
+  integration_label    = "no-version"
+  log_stream_type      = "Auto"
+  log_types            = ["AWS.CloudFrontAccess"]
+  auth_method          = "Bearer"
+  auth_bearer_token_wo = "write-only"
+}
+`,
+				ExpectError: regexp.MustCompile(`auth_bearer_token_wo_version`),
+			},
+		},
+	})
+}
+
+// TestHttpSourceResource_AuthMethodValidation checks that httpAuthMethodValidator requires
+// every auth field the method needs and rejects the rest at plan time. No API call.
+func TestHttpSourceResource_AuthMethodValidation(t *testing.T) {
+	cases := []struct {
+		name        string
+		auth        string
+		expectError string
+	}{
+		{"bearer_without_token", `auth_method = "Bearer"`, `auth_method "Bearer" requires auth_bearer_token \(or\s+auth_bearer_token_wo\)`},
+		{"hmac_without_alg", `
+  auth_method       = "HMAC"
+  auth_header_key   = "x-signature"
+  auth_secret_value = "secret"`, `auth_method "HMAC" requires auth_hmac_alg`},
+		{"basic_without_password", `
+  auth_method   = "Basic"
+  auth_username = "user"`, `auth_method "Basic" requires auth_password`},
+		{"none_with_secret", `
+  auth_method       = "None"
+  auth_secret_value = "secret"`, `auth_secret_value is not used by auth_method "None"`},
+		{"shared_secret_with_hmac_alg", `
+  auth_method       = "SharedSecret"
+  auth_header_key   = "x-api-key"
+  auth_secret_value = "secret"
+  auth_hmac_alg     = "sha256"`, `auth_hmac_alg is not used by auth_method\s+"SharedSecret"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_httpsource" "test" {
+  integration_label = "auth-validation"
+  log_stream_type   = "Auto"
+  log_types         = ["AWS.CloudFrontAccess"]
+  %s
+}
+`, tc.auth),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.expectError),
+					},
+				},
+			})
+		})
+	}
+}
+
+// TestHttpSourceResource_LogStreamTypeOptionsValidation checks that logStreamTypeOptionsValidator
+// requires the option JsonArray/XML need and rejects options the stream type ignores. The
+// validator is shared by every source resource; this exercises it through panther_httpsource.
+func TestHttpSourceResource_LogStreamTypeOptionsValidation(t *testing.T) {
+	cases := []struct {
+		name        string
+		stream      string
+		expectError string
+	}{
+		{"json_array_without_envelope_field", `log_stream_type = "JsonArray"`,
+			`log_stream_type "JsonArray" requires\s+log_stream_type_options.json_array_envelope_field`},
+		{"xml_without_root_element", `
+  log_stream_type         = "XML"
+  log_stream_type_options = { json_array_envelope_field = "records" }`,
+			`log_stream_type "XML" requires log_stream_type_options.xml_root_element`},
+		{"xml_with_envelope_field", `
+  log_stream_type         = "XML"
+  log_stream_type_options = {
+    xml_root_element          = "events"
+    json_array_envelope_field = "records"
+  }`, `log_stream_type_options.json_array_envelope_field is not used by\s+log_stream_type "XML"`},
+		{"json_with_root_element", `
+  log_stream_type         = "JSON"
+  log_stream_type_options = { xml_root_element = "root" }`,
+			`log_stream_type_options.xml_root_element is not used by log_stream_type\s+"JSON"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_httpsource" "test" {
+  integration_label = "stream-validation"
+  log_types         = ["AWS.CloudFrontAccess"]
+  auth_method       = "None"
+  %s
+}
+`, tc.stream),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.expectError),
+					},
+				},
+			})
+		})
+	}
+}
+
 func testHttpSourceResourceConfig(name string) string {
 	return fmt.Sprintf(`
 resource "panther_httpsource" "test" {
@@ -100,18 +292,30 @@ resource "panther_httpsource" "test" {
 `, name)
 }
 
+func testWriteOnlyHttpSourceResourceConfig(name, token string, version int) string {
+	return fmt.Sprintf(`
+resource "panther_httpsource" "test" {
+  integration_label            = %q
+  log_stream_type              = "Auto"
+  log_types                    = ["AWS.CloudFrontAccess"]
+  auth_method                  = "Bearer"
+  auth_bearer_token_wo         = %q
+  auth_bearer_token_wo_version = %d
+}
+`, name, token, version)
+}
+
 func testUpdatedHttpSourceResourceConfig(name string) string {
 	return fmt.Sprintf(`
 resource "panther_httpsource" "test" {
   integration_label     = "%v"
-  log_stream_type       = "JSON"
+  log_stream_type       = "JsonArray"
   log_types             = ["Zscaler.ZIA.WebLog"]
   auth_method         = "Basic"
   auth_username   	= "foo"
   auth_password 	= "bar"
   log_stream_type_options = {
     json_array_envelope_field = "records"
-	xml_root_element = "root"
   }
 }
 `, name)
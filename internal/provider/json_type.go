@@ -0,0 +1,70 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = jsonType{}
+	_ basetypes.StringValuableWithSemanticEquals = jsonValue{}
+	_ xattr.ValidateableAttribute                = jsonValue{}
+)
+
+// jsonType is a string attribute type holding a JSON document. Values that decode to the
+// same data are semantically equal, so the API re-serializing a document (key order,
+// whitespace) doesn't show up as drift. Array order is significant.
+type (
+	jsonType  = semanticStringType[jsonFormat]
+	jsonValue = semanticStringValue[jsonFormat]
+)
+
+type jsonFormat struct{}
+
+func newJSONValue(s string) jsonValue {
+	return jsonValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+func (jsonFormat) typeName() string {
+	return "jsonType"
+}
+
+// decode decodes numbers as json.Number, so 1 and 1.0 differ as they would to the
+// detection under test, and large integers don't lose precision.
+func (jsonFormat) decode(s string) (any, error) {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+	var data any
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after the JSON document")
+	}
+	return data, nil
+}
+
+func (jsonFormat) invalid(error) (string, string) {
+	return "Invalid JSON", "The value is not a valid JSON document. Build it with jsonencode() to avoid quoting mistakes."
+}
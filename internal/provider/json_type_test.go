@@ -0,0 +1,62 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONValue_SemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	base := newJSONValue(`{"eventName":"ConsoleLogin","userIdentity":{"type":"Root"},"count":1}`)
+	cases := map[string]struct {
+		other string
+		equal bool
+	}{
+		"reordered keys":  {`{"count":1,"userIdentity":{"type":"Root"},"eventName":"ConsoleLogin"}`, true},
+		"indented":        {"{\n  \"count\": 1,\n  \"eventName\": \"ConsoleLogin\",\n  \"userIdentity\": {\"type\": \"Root\"}\n}\n", true},
+		"changed value":   {`{"eventName":"ConsoleLogin","userIdentity":{"type":"IAMUser"},"count":1}`, false},
+		"float vs int":    {`{"eventName":"ConsoleLogin","userIdentity":{"type":"Root"},"count":1.0}`, false},
+		"trailing data":   {`{"eventName":"ConsoleLogin","userIdentity":{"type":"Root"},"count":1} {}`, false},
+		"invalid":         {`{"eventName":`, false},
+		"reordered array": {`[2,1]`, false},
+	}
+	for name, tc := range cases {
+		equal, diags := base.StringSemanticEquals(ctx, newJSONValue(tc.other))
+		assert.False(t, diags.HasError(), name)
+		assert.Equal(t, tc.equal, equal, name)
+	}
+}
+
+func TestJSONValue_ValidateAttribute(t *testing.T) {
+	ctx := context.Background()
+	req := xattr.ValidateAttributeRequest{Path: path.Root("resource")}
+
+	var valid xattr.ValidateAttributeResponse
+	newJSONValue(`{"a": [1, 2]}`).ValidateAttribute(ctx, req, &valid)
+	assert.False(t, valid.Diagnostics.HasError())
+
+	var invalid xattr.ValidateAttributeResponse
+	newJSONValue(`{"a":`).ValidateAttribute(ctx, req, &invalid)
+	assert.True(t, invalid.Diagnostics.HasError())
+	assert.Equal(t, "Invalid JSON", invalid.Diagnostics[0].Summary())
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = (*logSourceAlarmDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*logSourceAlarmDataSource)(nil)
+)
+
+func NewLogSourceAlarmDataSource() datasource.DataSource {
+	return &logSourceAlarmDataSource{}
+}
+
+// logSourceAlarmDataSource is the read-only counterpart to panther_log_source_alarm. Unlike
+// the resource it exposes the runtime `state`, and reads the system-managed alarms whether
+// or not their configuration is managed.
+type logSourceAlarmDataSource struct {
+	rest *client.RESTClient
+}
+
+type logSourceAlarmDataSourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	SourceId            types.String `tfsdk:"source_id"`
+	Type                types.String `tfsdk:"type"`
+	State               types.String `tfsdk:"state"`
+	MinutesThreshold    types.Int64  `tfsdk:"minutes_threshold"`
+	PercentageThreshold types.Int64  `tfsdk:"percentage_threshold"`
+	CountThreshold      types.Int64  `tfsdk:"count_threshold"`
+	Muted               types.Bool   `tfsdk:"muted"`
+	DestinationIds      types.List   `tfsdk:"destination_ids"`
+}
+
+func (d *logSourceAlarmDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_log_source_alarm"
+}
+
+func (d *logSourceAlarmDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	alarmTypes := append([]string{AlarmTypeSourceNoData}, systemManagedAlarmTypes...)
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the current state of a Panther log source alarm. Use it to gate deployments " +
+			"or in `check` blocks on source health. Covers the `" + AlarmTypeSourceNoData +
+			"` alarm and the system-managed alarm types; configure them with the `panther_log_source_alarm` resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Composite identifier in the form `{source_id}/{type}`.",
+			},
+			"source_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the log source the alarm belongs to.",
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The alarm type. One of `" + strings.Join(alarmTypes, "`, `") + "`.",
+				Validators:          []validator.String{stringvalidator.OneOf(alarmTypes...)},
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The runtime alarm state: `OK`, `ALARM` or `INSUFFICIENT_DATA`.",
+			},
+			"minutes_threshold": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The no-data threshold in minutes. Null for the system-managed alarm types.",
+			},
+			"percentage_threshold": schema.Int64Attribute{
+				Computed: true,
+				MarkdownDescription: "The percentage of failing events that raises a `" + AlarmTypeSourceClassificationFailures +
+					"` or `" + AlarmTypeSourceLogProcessingErrors + "` alarm. Null when the alarm uses a count or has no threshold.",
+			},
+			"count_threshold": schema.Int64Attribute{
+				Computed: true,
+				MarkdownDescription: "The number of failing events that raises a `" + AlarmTypeSourceClassificationFailures +
+					"` or `" + AlarmTypeSourceLogProcessingErrors + "` alarm. Null when the alarm uses a percentage or has no threshold.",
+			},
+			"muted": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the alarm's alerts are suppressed.",
+			},
+			"destination_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The destinations the alarm's alerts are sent to. Null when they're routed by the destinations' `alert_types`.",
+			},
+		},
+	}
+}
+
+func (d *logSourceAlarmDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.rest = dataSourceRESTClient(req, resp)
+}
+
+func (d *logSourceAlarmDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data logSourceAlarmDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.SourceId.ValueString() + "/" + data.Type.ValueString()
+	alarm, err := client.RestDo[client.LogSourceAlarmStatus](ctx, d.rest, http.MethodGet,
+		alarmPath(data.SourceId.ValueString(), data.Type.ValueString()), nil)
+	if handleDataSourceReadError(&resp.Diagnostics, "Log Source Alarm", fmt.Sprintf("id %q", id), err) {
+		return
+	}
+	tflog.Debug(ctx, "Read Log Source Alarm data source", map[string]any{"id": id, "state": alarm.State})
+
+	data.Id = types.StringValue(id)
+	data.State = types.StringValue(alarm.State)
+	var config logSourceAlarmModel
+	config.set(ctx, alarm.LogSourceAlarm, &resp.Diagnostics)
+	data.MinutesThreshold = config.MinutesThreshold
+	data.PercentageThreshold = config.PercentageThreshold
+	data.CountThreshold = config.CountThreshold
+	data.Muted = config.Muted
+	data.DestinationIds = config.DestinationIds
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestLogSourceAlarmDataSource reads the configured SOURCE_NO_DATA alarm and one
+// system-managed alarm off the same httpsource parent used by TestLogSourceAlarmResource.
+// Both data sources reference the alarm resource so they're read after it's created.
+func TestLogSourceAlarmDataSource(t *testing.T) {
+	parentLabel := strings.ReplaceAll(uuid.NewString(), "-", "")
+	lookups := `
+data "panther_log_source_alarm" "no_data" {
+  source_id = panther_log_source_alarm.test.source_id
+  type      = panther_log_source_alarm.test.type
+}
+
+data "panther_log_source_alarm" "permissions" {
+  source_id = panther_log_source_alarm.test.source_id
+  type      = "SOURCE_PERMISSIONS_CHECKS"
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testLogSourceAlarmConfig(parentLabel, 60) + lookups,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.panther_log_source_alarm.no_data", "id", "panther_log_source_alarm.test", "id"),
+					resource.TestCheckResourceAttr("data.panther_log_source_alarm.no_data", "minutes_threshold", "60"),
+					resource.TestCheckResourceAttrSet("data.panther_log_source_alarm.no_data", "state"),
+					resource.TestMatchResourceAttr("data.panther_log_source_alarm.permissions", "id", regexp.MustCompile(`/SOURCE_PERMISSIONS_CHECKS$`)),
+					resource.TestCheckResourceAttrSet("data.panther_log_source_alarm.permissions", "state"),
+					resource.TestCheckNoResourceAttr("data.panther_log_source_alarm.permissions", "minutes_threshold"),
+				),
+			},
+			{
+				Config:             providerConfig + testLogSourceAlarmConfig(parentLabel, 60),
+				Check:              manuallyDeleteSource(t, "panther_httpsource.parent", httpSourcePath),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestLogSourceAlarmDataSource_NotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "panther_log_source_alarm" "test" {
+  source_id = "ffffffff-ffff-4fff-bfff-ffffffffffff"
+  type      = "SOURCE_NO_DATA"
+}
+`,
+				ExpectError: regexp.MustCompile(`Log Source Alarm not found`),
+			},
+		},
+	})
+}
+
+// TestLogSourceAlarmDataSource_InvalidType verifies the data source rejects unknown
+// alarm types. No API call.
+func TestLogSourceAlarmDataSource_InvalidType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "panther_log_source_alarm" "test" {
+  source_id = "00000000-0000-0000-0000-000000000000"
+  type      = "SOURCE_HIGH_VOLUME"
+}
+`,
+				ExpectError: regexp.MustCompile(`SOURCE_PERMISSIONS_CHECKS`),
+			},
+		},
+	})
+}
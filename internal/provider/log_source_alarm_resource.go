@@ -20,16 +20,20 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
 	"terraform-provider-panther/internal/client"
 	"terraform-provider-panther/internal/provider/resource_log_source_alarm"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -39,15 +43,43 @@ import (
 
 const logSourceAlarmPath = "/log-source-alarms"
 
-// AlarmTypeSourceNoData is the only alarm type the REST API currently exposes. The four
-// system-managed types (permissions, classification, processing, scanning) are intentionally
-// not surfaced (see panther-enterprise PR #28642).
+// AlarmTypeSourceNoData is the alarm users add to a source: it fires when the source
+// receives no data for minutes_threshold minutes.
 const AlarmTypeSourceNoData = "SOURCE_NO_DATA"
 
+const (
+	AlarmTypeSourcePermissionsChecks      = "SOURCE_PERMISSIONS_CHECKS"
+	AlarmTypeSourceClassificationFailures = "SOURCE_CLASSIFICATION_FAILURES"
+	AlarmTypeSourceLogProcessingErrors    = "SOURCE_LOG_PROCESSING_ERRORS"
+	AlarmTypeSourceScanningErrors         = "SOURCE_SCANNING_ERRORS"
+)
+
+// systemManagedAlarmTypes are raised and cleared by Panther itself and exist for every
+// source. Only how they notify can be configured; deleting one resets it to Panther's
+// defaults.
+var systemManagedAlarmTypes = []string{
+	AlarmTypeSourcePermissionsChecks,
+	AlarmTypeSourceClassificationFailures,
+	AlarmTypeSourceLogProcessingErrors,
+	AlarmTypeSourceScanningErrors,
+}
+
+// alarmThresholds lists the threshold attributes each alarm type takes, of which exactly
+// one must be set. Types without an entry have no threshold.
+var alarmThresholds = map[string][]string{
+	AlarmTypeSourceNoData:                 {"minutes_threshold"},
+	AlarmTypeSourceClassificationFailures: {"percentage_threshold", "count_threshold"},
+	AlarmTypeSourceLogProcessingErrors:    {"percentage_threshold", "count_threshold"},
+}
+
+var alarmThresholdAttributes = []string{"minutes_threshold", "percentage_threshold", "count_threshold"}
+
 var (
-	_ resource.Resource                = (*logSourceAlarmResource)(nil)
-	_ resource.ResourceWithConfigure   = (*logSourceAlarmResource)(nil)
-	_ resource.ResourceWithImportState = (*logSourceAlarmResource)(nil)
+	_ resource.Resource                     = (*logSourceAlarmResource)(nil)
+	_ resource.ResourceWithConfigure        = (*logSourceAlarmResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*logSourceAlarmResource)(nil)
+	_ resource.ResourceWithImportState      = (*logSourceAlarmResource)(nil)
+	_ resource.ResourceWithUpgradeState     = (*logSourceAlarmResource)(nil)
 )
 
 func NewLogSourceAlarmResource() resource.Resource {
@@ -63,10 +95,14 @@ type logSourceAlarmResource struct {
 // the tfsdk tags match the augmented schema exactly; regenerating the package does not
 // disturb this file.
 type logSourceAlarmModel struct {
-	Id               types.String `tfsdk:"id"`
-	SourceId         types.String `tfsdk:"source_id"`
-	Type             types.String `tfsdk:"type"`
-	MinutesThreshold types.Int64  `tfsdk:"minutes_threshold"`
+	Id                  types.String `tfsdk:"id"`
+	SourceId            types.String `tfsdk:"source_id"`
+	Type                types.String `tfsdk:"type"`
+	MinutesThreshold    types.Int64  `tfsdk:"minutes_threshold"`
+	PercentageThreshold types.Int64  `tfsdk:"percentage_threshold"`
+	CountThreshold      types.Int64  `tfsdk:"count_threshold"`
+	Muted               types.Bool   `tfsdk:"muted"`
+	DestinationIds      types.List   `tfsdk:"destination_ids"`
 }
 
 func (r *logSourceAlarmResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -75,41 +111,38 @@ func (r *logSourceAlarmResource) Metadata(_ context.Context, req resource.Metada
 
 func (r *logSourceAlarmResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_log_source_alarm.LogSourceAlarmResourceSchema(ctx)
+	resp.Schema.Version = 1
 
-	// Scope rationale (kept as a code comment, not user-facing docs): only the
-	// SOURCE_NO_DATA alarm is configurable here. The four system-managed types
-	// (SOURCE_PERMISSIONS_CHECKS, SOURCE_CLASSIFICATION_FAILURES,
-	// SOURCE_LOG_PROCESSING_ERRORS, SOURCE_SCANNING_ERRORS) flip OK/ALARM at
-	// runtime based on conditions the user doesn't directly control, so they
-	// don't fit Terraform's declarative plan/apply model. The OneOf validator
-	// on the `type` attribute enforces the same scope at the schema layer.
-	resp.Schema.Description = "Manages a no-data drop-off alarm for a Panther log source"
-	resp.Schema.MarkdownDescription = "Manages a no-data drop-off alarm for a Panther log source"
+	resp.Schema.MarkdownDescription = "Manages a Panther log source alarm: the `" + AlarmTypeSourceNoData + "` drop-off " +
+		"alarm, or how one of the system-managed alarms Panther keeps for every source notifies. Destroying a " +
+		"system-managed alarm resets it to Panther's defaults rather than removing it."
+	resp.Schema.Description = resp.Schema.MarkdownDescription
 
 	// The generator models path parameters as computed/optional based on OpenAPI inference.
 	// Both source_id and type are user-supplied path parameters; rewrite them as Required
 	// with RequiresReplace (changing either identifies a different alarm resource).
 	resp.Schema.Attributes["source_id"] = schema.StringAttribute{
 		Required: true,
-		Description: "The ID of the log source this alarm monitors (the `id` of a " +
-			"`panther_s3_source`, `panther_httpsource`, `panther_gcssource`, or `panther_pubsubsource`). " +
+		Description: "The ID of the log source this alarm monitors (the `id` of any log source resource, " +
+			"e.g. `panther_s3_source` or `panther_azure_blob_source`). " +
 			"Changing this forces resource recreation.",
-		MarkdownDescription: "The ID of the log source this alarm monitors (the `id` of a " +
-			"`panther_s3_source`, `panther_httpsource`, `panther_gcssource`, or `panther_pubsubsource`). " +
+		MarkdownDescription: "The ID of the log source this alarm monitors (the `id` of any log source resource, " +
+			"e.g. `panther_s3_source` or `panther_azure_blob_source`). " +
 			"Changing this forces resource recreation.",
 		PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 	}
+	alarmTypes := append([]string{AlarmTypeSourceNoData}, systemManagedAlarmTypes...)
 	resp.Schema.Attributes["type"] = schema.StringAttribute{
 		Required: true,
-		Description: fmt.Sprintf(
-			"The alarm type. Must be %q. Changing this forces resource recreation.",
-			AlarmTypeSourceNoData,
-		),
-		MarkdownDescription: fmt.Sprintf(
-			"The alarm type. Must be `%s`. Changing this forces resource recreation.",
-			AlarmTypeSourceNoData,
-		),
-		Validators:    []validator.String{stringvalidator.OneOf(AlarmTypeSourceNoData)},
+		Description: fmt.Sprintf("The alarm type. One of %s. The type decides which threshold applies: "+
+			"minutes_threshold for %s, percentage_threshold or count_threshold for %s and %s, none for the others. "+
+			"Changing this forces resource recreation.", strings.Join(alarmTypes, ", "),
+			AlarmTypeSourceNoData, AlarmTypeSourceClassificationFailures, AlarmTypeSourceLogProcessingErrors),
+		MarkdownDescription: fmt.Sprintf("The alarm type. One of `%s`. The type decides which threshold applies: "+
+			"`minutes_threshold` for `%s`, `percentage_threshold` or `count_threshold` for `%s` and `%s`, none for the others. "+
+			"Changing this forces resource recreation.", strings.Join(alarmTypes, "`, `"),
+			AlarmTypeSourceNoData, AlarmTypeSourceClassificationFailures, AlarmTypeSourceLogProcessingErrors),
+		Validators:    []validator.String{stringvalidator.OneOf(alarmTypes...)},
 		PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 	}
 
@@ -123,12 +156,43 @@ func (r *logSourceAlarmResource) Schema(ctx context.Context, _ resource.SchemaRe
 		PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 	}
 
-	// minutes_threshold: attach provider-side bounds. The OpenAPI spec intentionally omits
+	// Thresholds: attach provider-side bounds. The OpenAPI spec intentionally omits
 	// JSON-schema minimum/maximum (service-layer enforcement with customer-facing grammar),
-	// so we wrap it here for fail-fast plan-time validation.
+	// so we wrap them here for fail-fast plan-time validation.
 	mt := resp.Schema.Attributes["minutes_threshold"].(schema.Int64Attribute)
 	mt.Validators = append(mt.Validators, int64validator.Between(15, 43200))
 	resp.Schema.Attributes["minutes_threshold"] = mt
+
+	pt := resp.Schema.Attributes["percentage_threshold"].(schema.Int64Attribute)
+	pt.Description += ". Between 1 and 100."
+	pt.MarkdownDescription = pt.Description
+	pt.Validators = append(pt.Validators, int64validator.Between(1, 100))
+	resp.Schema.Attributes["percentage_threshold"] = pt
+
+	ct := resp.Schema.Attributes["count_threshold"].(schema.Int64Attribute)
+	ct.Description += ". At least 1."
+	ct.MarkdownDescription = ct.Description
+	ct.Validators = append(ct.Validators, int64validator.AtLeast(1))
+	resp.Schema.Attributes["count_threshold"] = ct
+
+	muted := resp.Schema.Attributes["muted"].(schema.BoolAttribute)
+	muted.Description += ". The alarm still changes state while muted. Defaults to false."
+	muted.MarkdownDescription = muted.Description
+	muted.Default = booldefault.StaticBool(false)
+	resp.Schema.Attributes["muted"] = muted
+
+	// Without destination_ids the alerts go wherever SYSTEM_ERROR alerts go; an empty
+	// list would be ambiguous, so it's rejected.
+	destinations := resp.Schema.Attributes["destination_ids"].(schema.ListAttribute)
+	destinations.Description += ". Leave unset to route them like any other SYSTEM_ERROR alert, by the " +
+		"destinations' alert_types."
+	destinations.MarkdownDescription = destinations.Description
+	destinations.Validators = append(destinations.Validators, listvalidator.SizeAtLeast(1), listvalidator.UniqueValues())
+	resp.Schema.Attributes["destination_ids"] = destinations
+}
+
+func (r *logSourceAlarmResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{alarmThresholdsValidator{}}
 }
 
 func (r *logSourceAlarmResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -143,8 +207,9 @@ func (r *logSourceAlarmResource) Create(ctx context.Context, req resource.Create
 	}
 
 	reqPath := alarmPath(data.SourceId.ValueString(), data.Type.ValueString())
-	input := client.LogSourceAlarmInput{
-		MinutesThreshold: data.MinutesThreshold.ValueInt64(),
+	input := data.toAPI(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 	putResp, err := client.RestDo[client.LogSourceAlarm](ctx, r.rest, http.MethodPut, reqPath, input)
 	if handleCreateError(resp, "Log Source Alarm", err) {
@@ -156,8 +221,7 @@ func (r *logSourceAlarmResource) Create(ctx context.Context, req resource.Create
 	})
 
 	data.Id = types.StringValue(data.SourceId.ValueString() + "/" + data.Type.ValueString())
-	data.Type = types.StringValue(putResp.Type)
-	data.MinutesThreshold = types.Int64Value(putResp.MinutesThreshold)
+	data.set(ctx, putResp, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -174,8 +238,7 @@ func (r *logSourceAlarmResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	data.MinutesThreshold = types.Int64Value(alarm.MinutesThreshold)
-	data.Type = types.StringValue(alarm.Type)
+	data.set(ctx, alarm, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -187,8 +250,9 @@ func (r *logSourceAlarmResource) Update(ctx context.Context, req resource.Update
 	}
 
 	reqPath := alarmPath(data.SourceId.ValueString(), data.Type.ValueString())
-	input := client.LogSourceAlarmInput{
-		MinutesThreshold: data.MinutesThreshold.ValueInt64(),
+	input := data.toAPI(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 	putResp, err := client.RestDo[client.LogSourceAlarm](ctx, r.rest, http.MethodPut, reqPath, input)
 	if handleUpdateError(ctx, resp, "Log Source Alarm", data.Id.ValueString(), err) {
@@ -198,8 +262,7 @@ func (r *logSourceAlarmResource) Update(ctx context.Context, req resource.Update
 		"id": data.Id.ValueString(),
 	})
 
-	data.Type = types.StringValue(putResp.Type)
-	data.MinutesThreshold = types.Int64Value(putResp.MinutesThreshold)
+	data.set(ctx, putResp, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -234,6 +297,130 @@ func (r *logSourceAlarmResource) ImportState(ctx context.Context, req resource.I
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), parts[1])...)
 }
 
+// UpgradeState upgrades state written before the resource managed every alarm type.
+// Version 0 had only SOURCE_NO_DATA alarms and no muted attribute; the provider never
+// sent muted, so Panther stored false. Setting it here keeps the muted default from
+// planning an update to every existing alarm when the state isn't refreshed first.
+func (r *logSourceAlarmResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                schema.StringAttribute{Computed: true},
+					"source_id":         schema.StringAttribute{Required: true},
+					"type":              schema.StringAttribute{Required: true},
+					"minutes_threshold": schema.Int64Attribute{Required: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior struct {
+					Id               types.String `tfsdk:"id"`
+					SourceId         types.String `tfsdk:"source_id"`
+					Type             types.String `tfsdk:"type"`
+					MinutesThreshold types.Int64  `tfsdk:"minutes_threshold"`
+				}
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(resp.State.Set(ctx, logSourceAlarmModel{
+					Id:                  prior.Id,
+					SourceId:            prior.SourceId,
+					Type:                prior.Type,
+					MinutesThreshold:    prior.MinutesThreshold,
+					PercentageThreshold: types.Int64Null(),
+					CountThreshold:      types.Int64Null(),
+					Muted:               types.BoolValue(false),
+					DestinationIds:      types.ListNull(types.StringType),
+				})...)
+			},
+		},
+	}
+}
+
 func alarmPath(sourceID, alarmType string) string {
 	return logSourceAlarmPath + "/" + sourceID + "/" + alarmType
 }
+
+func (m logSourceAlarmModel) toAPI(ctx context.Context, diagnostics *diag.Diagnostics) client.LogSourceAlarmInput {
+	return client.LogSourceAlarmInput{
+		MinutesThreshold:    m.MinutesThreshold.ValueInt64(),
+		PercentageThreshold: m.PercentageThreshold.ValueInt64(),
+		CountThreshold:      m.CountThreshold.ValueInt64(),
+		Muted:               m.Muted.ValueBool(),
+		DestinationIds:      listToStringSlice(ctx, m.DestinationIds, diagnostics),
+	}
+}
+
+// set copies an API response into the model. The API omits thresholds the alarm type
+// doesn't use and destination_ids when the alerts use default routing; both are null
+// in state.
+func (m *logSourceAlarmModel) set(ctx context.Context, alarm client.LogSourceAlarm, diagnostics *diag.Diagnostics) {
+	m.Type = types.StringValue(alarm.Type)
+	m.MinutesThreshold = positiveInt64OrNull(alarm.MinutesThreshold)
+	m.PercentageThreshold = positiveInt64OrNull(alarm.PercentageThreshold)
+	m.CountThreshold = positiveInt64OrNull(alarm.CountThreshold)
+	m.Muted = types.BoolValue(alarm.Muted)
+	m.DestinationIds = types.ListNull(types.StringType)
+	if len(alarm.DestinationIds) > 0 {
+		m.DestinationIds = stringSliceToList(ctx, alarm.DestinationIds, diagnostics)
+	}
+}
+
+func positiveInt64OrNull(value int64) types.Int64 {
+	if value <= 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(value)
+}
+
+// alarmThresholdsValidator checks the configured thresholds against alarmThresholds.
+type alarmThresholdsValidator struct{}
+
+func (v alarmThresholdsValidator) Description(_ context.Context) string {
+	return "the thresholds must match type"
+}
+
+func (v alarmThresholdsValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v alarmThresholdsValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var alarmType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &alarmType)...)
+	if resp.Diagnostics.HasError() || alarmType.IsNull() || alarmType.IsUnknown() {
+		return
+	}
+	allowed := alarmThresholds[alarmType.ValueString()]
+
+	var set []string
+	for _, name := range alarmThresholdAttributes {
+		var value types.Int64
+		if diags := req.Config.GetAttribute(ctx, path.Root(name), &value); diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+		if value.IsNull() {
+			continue
+		}
+		if slices.Contains(allowed, name) {
+			set = append(set, name)
+			continue
+		}
+		uses := "which have no threshold"
+		if len(allowed) > 0 {
+			uses = "which take " + strings.Join(allowed, " or ")
+		}
+		resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Attribute Combination",
+			fmt.Sprintf("%s is not used by %s alarms, %s. Remove it.", name, alarmType.ValueString(), uses))
+	}
+
+	switch {
+	case len(allowed) > 0 && len(set) == 0:
+		resp.Diagnostics.AddAttributeError(path.Root(allowed[0]), "Missing Attribute Configuration",
+			fmt.Sprintf("%s alarms require %s.", alarmType.ValueString(), strings.Join(allowed, " or ")))
+	case len(set) > 1:
+		resp.Diagnostics.AddAttributeError(path.Root(set[1]), "Invalid Attribute Combination",
+			fmt.Sprintf("%s alarms take only one of %s.", alarmType.ValueString(), strings.Join(set, " and ")))
+	}
+}
@@ -27,8 +27,13 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"terraform-provider-panther/internal/client"
 )
@@ -49,7 +54,7 @@ import (
 var (
 	compositeIDRegex     = regexp.MustCompile(`^[0-9a-f-]+/SOURCE_NO_DATA$`)
 	thresholdBoundsRegex = regexp.MustCompile(`between 15 and 43200`)
-	invalidTypeRegex     = regexp.MustCompile(`must be one of: \["SOURCE_NO_DATA"]`)
+	invalidTypeRegex     = regexp.MustCompile(`(?s)must be one of:.*"SOURCE_NO_DATA"`)
 	invalidImportIDRegex = regexp.MustCompile(`Invalid Import ID`)
 	notFoundSourceRegex  = regexp.MustCompile(`log source was not found|404`)
 )
@@ -202,8 +207,8 @@ func TestLogSourceAlarmResource_InvalidThreshold(t *testing.T) {
 	}
 }
 
-// TestLogSourceAlarmResource_InvalidType verifies the stringvalidator.OneOf rejects alarm
-// types other than SOURCE_NO_DATA at plan time. No API call.
+// TestLogSourceAlarmResource_InvalidType verifies the stringvalidator.OneOf rejects
+// unknown alarm types at plan time. No API call.
 func TestLogSourceAlarmResource_InvalidType(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
@@ -213,7 +218,7 @@ func TestLogSourceAlarmResource_InvalidType(t *testing.T) {
 				Config: providerConfig + `
 resource "panther_log_source_alarm" "test" {
   source_id         = "00000000-0000-0000-0000-000000000000"
-  type              = "SOURCE_PERMISSIONS_CHECKS"
+  type              = "SOURCE_HIGH_VOLUME"
   minutes_threshold = 60
 }
 `,
@@ -223,6 +228,165 @@ resource "panther_log_source_alarm" "test" {
 	})
 }
 
+// TestLogSourceAlarmResource_SystemManaged configures a classification-failures alarm,
+// first with a percentage routed to a destination, then with a count, muted and routed
+// by default, and mutes a permissions alarm, which has no threshold. The parent is
+// deleted out of band at the end like in TestLogSourceAlarmResource.
+func TestLogSourceAlarmResource_SystemManaged(t *testing.T) {
+	t.Parallel()
+	parentLabel := strings.ReplaceAll(uuid.NewString(), "-", "")
+	config := func(classification string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "panther_httpsource" "parent" {
+  integration_label = %q
+  log_stream_type   = "Auto"
+  log_types         = ["AWS.CloudFrontAccess"]
+  auth_method       = "SharedSecret"
+  auth_header_key   = "x-api-key"
+  auth_secret_value = "test-secret-value"
+}
+
+resource "panther_destination_slack" "source_health" {
+  display_name = "test-source-health-%s"
+  webhook_url  = "https://hooks.slack.com/services/T000/B000/XXXX"
+  alert_types  = ["SYSTEM_ERROR"]
+}
+
+resource "panther_log_source_alarm" "classification" {
+  source_id = panther_httpsource.parent.id
+  type      = "SOURCE_CLASSIFICATION_FAILURES"
+  %s
+}
+
+resource "panther_log_source_alarm" "permissions" {
+  source_id = panther_httpsource.parent.id
+  type      = "SOURCE_PERMISSIONS_CHECKS"
+  muted     = true
+}
+`, parentLabel, parentLabel, classification)
+	}
+	const address = "panther_log_source_alarm.classification"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`percentage_threshold = 5
+  destination_ids      = [panther_destination_slack.source_health.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(address, "id", regexp.MustCompile(`/SOURCE_CLASSIFICATION_FAILURES$`)),
+					resource.TestCheckResourceAttr(address, "percentage_threshold", "5"),
+					resource.TestCheckNoResourceAttr(address, "count_threshold"),
+					resource.TestCheckNoResourceAttr(address, "minutes_threshold"),
+					resource.TestCheckResourceAttr(address, "muted", "false"),
+					resource.TestCheckResourceAttrPair(address, "destination_ids.0", "panther_destination_slack.source_health", "id"),
+					resource.TestCheckResourceAttr("panther_log_source_alarm.permissions", "muted", "true"),
+					resource.TestCheckNoResourceAttr("panther_log_source_alarm.permissions", "count_threshold"),
+				),
+			},
+			{
+				ResourceName:      address,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(`count_threshold = 100
+  muted           = true`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(address, "count_threshold", "100"),
+					resource.TestCheckNoResourceAttr(address, "percentage_threshold"),
+					resource.TestCheckResourceAttr(address, "muted", "true"),
+					resource.TestCheckNoResourceAttr(address, "destination_ids.#"),
+				),
+			},
+			{
+				Config:             config(`count_threshold = 100`),
+				Check:              manuallyDeleteSource(t, "panther_httpsource.parent", httpSourcePath),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// TestLogSourceAlarmResource_ThresholdsPerType verifies alarmThresholdsValidator and the
+// per-attribute bounds at plan time. No API call.
+func TestLogSourceAlarmResource_ThresholdsPerType(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name        string
+		alarmType   string
+		attributes  string
+		expectError string
+	}{
+		{"no_data_without_minutes", "SOURCE_NO_DATA", `muted = true`, `SOURCE_NO_DATA alarms require\s+minutes_threshold`},
+		{"no_data_with_percentage", "SOURCE_NO_DATA", `minutes_threshold    = 60
+  percentage_threshold = 5`, `percentage_threshold is not used by SOURCE_NO_DATA alarms,\s+which take\s+minutes_threshold`},
+		{"classification_without_threshold", "SOURCE_CLASSIFICATION_FAILURES", `muted = true`, `require\s+percentage_threshold\s+or\s+count_threshold`},
+		{"classification_with_both", "SOURCE_CLASSIFICATION_FAILURES", `percentage_threshold = 5
+  count_threshold      = 10`, `take\s+only\s+one\s+of\s+percentage_threshold\s+and\s+count_threshold`},
+		{"processing_with_minutes", "SOURCE_LOG_PROCESSING_ERRORS", `count_threshold   = 10
+  minutes_threshold = 60`, `minutes_threshold is not used by\s+SOURCE_LOG_PROCESSING_ERRORS`},
+		{"permissions_with_count", "SOURCE_PERMISSIONS_CHECKS", `count_threshold = 10`, `which\s+have\s+no\s+threshold`},
+		{"percentage_over_100", "SOURCE_LOG_PROCESSING_ERRORS", `percentage_threshold = 101`, `between 1 and 100`},
+		{"empty_destinations", "SOURCE_SCANNING_ERRORS", `destination_ids = []`, `at least 1`},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_log_source_alarm" "test" {
+  source_id = "00000000-0000-0000-0000-000000000000"
+  type      = %q
+  %s
+}
+`, tc.alarmType, tc.attributes),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.expectError),
+					},
+				},
+			})
+		})
+	}
+}
+
+// TestLogSourceAlarmResource_UpgradeStateV0 upgrades state from before muted existed:
+// muted must come out false, so the default doesn't plan an update. No API call.
+func TestLogSourceAlarmResource_UpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := &logSourceAlarmResource{}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	upgrader := r.UpgradeState(ctx)[0]
+
+	prior := tfsdk.State{
+		Schema: *upgrader.PriorSchema,
+		Raw: tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"id":                tftypes.NewValue(tftypes.String, "src/SOURCE_NO_DATA"),
+			"source_id":         tftypes.NewValue(tftypes.String, "src"),
+			"type":              tftypes.NewValue(tftypes.String, AlarmTypeSourceNoData),
+			"minutes_threshold": tftypes.NewValue(tftypes.Number, 60),
+		}),
+	}
+	resp := fwresource.UpgradeStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	upgrader.StateUpgrader(ctx, fwresource.UpgradeStateRequest{State: &prior}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var got logSourceAlarmModel
+	require.False(t, resp.State.Get(ctx, &got).HasError())
+	assert.Equal(t, "src/SOURCE_NO_DATA", got.Id.ValueString())
+	assert.Equal(t, int64(60), got.MinutesThreshold.ValueInt64())
+	assert.False(t, got.Muted.IsNull())
+	assert.False(t, got.Muted.ValueBool())
+	assert.True(t, got.PercentageThreshold.IsNull())
+	assert.True(t, got.DestinationIds.IsNull())
+}
+
 // TestLogSourceAlarmResource_NonexistentSource verifies the API's pre-flight 404 (when the
 // sourceId doesn't resolve to a real log source) bubbles through handleCreateError as an
 // actionable diagnostic. Exercises the default branch of handleCreateError (neither
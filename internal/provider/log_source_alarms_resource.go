@@ -0,0 +1,298 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxParallelAlarmWrites caps the alarm PUTs and DELETEs one apply of
+// panther_log_source_alarms has in flight, on top of the provider's
+// max_concurrent_requests. It matches Terraform's default -parallelism.
+const maxParallelAlarmWrites = 10
+
+var (
+	_ resource.Resource                = (*logSourceAlarmsResource)(nil)
+	_ resource.ResourceWithConfigure   = (*logSourceAlarmsResource)(nil)
+	_ resource.ResourceWithImportState = (*logSourceAlarmsResource)(nil)
+)
+
+func NewLogSourceAlarmsResource() resource.Resource {
+	return &logSourceAlarmsResource{}
+}
+
+// logSourceAlarmsResource manages the SOURCE_NO_DATA alarm of many sources as one
+// resource. The API has no bulk endpoint, so it writes the same
+// /log-source-alarms/{sourceId}/{type} paths as panther_log_source_alarm, in parallel,
+// and reads them all back with a single list.
+type logSourceAlarmsResource struct {
+	rest *client.RESTClient
+}
+
+type logSourceAlarmsModel struct {
+	Id                types.String `tfsdk:"id"`
+	MinutesThresholds types.Map    `tfsdk:"minutes_thresholds"`
+}
+
+func (r *logSourceAlarmsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_log_source_alarms"
+}
+
+func (r *logSourceAlarmsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the `" + AlarmTypeSourceNoData + "` alarm of many log sources at once, writing them " +
+			"in parallel. Sources left out of `minutes_thresholds` are not touched. Don't also manage an alarm in the " +
+			"set with `panther_log_source_alarm`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The alarm type, `" + AlarmTypeSourceNoData + "`.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"minutes_thresholds": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Required:    true,
+				MarkdownDescription: "The no-data threshold in minutes (15 to 43200) for each log source, keyed by source ID. " +
+					"Removing a source deletes its alarm.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+					mapvalidator.ValueInt64sAre(int64validator.Between(15, 43200)),
+				},
+			},
+		},
+	}
+}
+
+func (r *logSourceAlarmsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+// Create saves the alarms that were written even if others failed: Terraform taints a
+// resource whose create failed, and the state lets the replacement delete them instead
+// of leaking them.
+func (r *logSourceAlarmsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data logSourceAlarmsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planned := noDataThresholds(ctx, data.MinutesThresholds, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applied := r.write(ctx, map[string]int64{}, planned, &resp.Diagnostics)
+	tflog.Debug(ctx, "Created Log Source Alarms", map[string]any{"count": len(applied)})
+
+	data.Id = types.StringValue(AlarmTypeSourceNoData)
+	data.MinutesThresholds = noDataThresholdsMap(ctx, applied, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read lists every configured alarm in one paged request rather than reading the alarms
+// one by one. Alarms deleted outside Terraform drop out of state. After an import the
+// state has no thresholds yet, and every SOURCE_NO_DATA alarm is adopted.
+func (r *logSourceAlarmsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data logSourceAlarmsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alarms, err := client.RestList[client.LogSourceAlarm](ctx, r.rest, logSourceAlarmPath)
+	if handleReadError(ctx, resp, "Log Source Alarms", data.Id.ValueString(), err) {
+		return
+	}
+	remote := map[string]int64{}
+	for _, alarm := range alarms {
+		if alarm.Type == AlarmTypeSourceNoData {
+			remote[alarm.SourceId] = alarm.MinutesThreshold
+		}
+	}
+	tflog.Debug(ctx, "Listed Log Source Alarms", map[string]any{"count": len(remote)})
+
+	thresholds := remote
+	if !data.MinutesThresholds.IsNull() {
+		thresholds = map[string]int64{}
+		for sourceID := range noDataThresholds(ctx, data.MinutesThresholds, &resp.Diagnostics) {
+			if minutes, ok := remote[sourceID]; ok {
+				thresholds[sourceID] = minutes
+			}
+		}
+	}
+	data.MinutesThresholds = noDataThresholdsMap(ctx, thresholds, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update writes only the alarms that changed. Alarms that failed keep their prior state,
+// so the next plan retries them.
+func (r *logSourceAlarmsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, prior logSourceAlarmsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	current := noDataThresholds(ctx, prior.MinutesThresholds, &resp.Diagnostics)
+	planned := noDataThresholds(ctx, data.MinutesThresholds, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applied := r.write(ctx, current, planned, &resp.Diagnostics)
+	tflog.Debug(ctx, "Updated Log Source Alarms", map[string]any{"count": len(applied)})
+
+	data.MinutesThresholds = noDataThresholdsMap(ctx, applied, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete leaves the alarms it couldn't delete in state.
+func (r *logSourceAlarmsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data logSourceAlarmsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	current := noDataThresholds(ctx, data.MinutesThresholds, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remaining := r.write(ctx, current, map[string]int64{}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		data.MinutesThresholds = noDataThresholdsMap(ctx, remaining, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+	tflog.Debug(ctx, "Deleted Log Source Alarms", map[string]any{"count": len(current)})
+}
+
+// ImportState adopts every existing SOURCE_NO_DATA alarm; see Read.
+func (r *logSourceAlarmsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != AlarmTypeSourceNoData {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected %q, the only alarm type with a per-source threshold, got: %q", AlarmTypeSourceNoData, req.ID),
+		)
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// write moves the alarms from current to planned: a PUT for each threshold that is new
+// or changed and a DELETE for each source that was dropped, up to maxParallelAlarmWrites
+// at a time. Each failure is reported against its map entry. It returns current with
+// the successful writes applied.
+func (r *logSourceAlarmsResource) write(ctx context.Context, current, planned map[string]int64, diagnostics *diag.Diagnostics) map[string]int64 {
+	var sourceIDs []string
+	for sourceID, minutes := range planned {
+		if prior, ok := current[sourceID]; !ok || prior != minutes {
+			sourceIDs = append(sourceIDs, sourceID)
+		}
+	}
+	for sourceID := range current {
+		if _, ok := planned[sourceID]; !ok {
+			sourceIDs = append(sourceIDs, sourceID)
+		}
+	}
+	slices.Sort(sourceIDs)
+
+	errs := make([]error, len(sourceIDs))
+	sem := make(chan struct{}, maxParallelAlarmWrites)
+	var wg sync.WaitGroup
+	for i, sourceID := range sourceIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			reqPath := alarmPath(sourceID, AlarmTypeSourceNoData)
+			if minutes, ok := planned[sourceID]; ok {
+				input := client.LogSourceAlarmInput{MinutesThreshold: minutes}
+				_, errs[i] = client.RestDo[client.LogSourceAlarm](ctx, r.rest, http.MethodPut, reqPath, input)
+			} else if err := client.RestDelete(ctx, r.rest, reqPath); !client.IsNotFound(err) {
+				errs[i] = err
+			}
+		}()
+	}
+	wg.Wait()
+
+	result := maps.Clone(current)
+	authReported := false
+	for i, sourceID := range sourceIDs {
+		if errs[i] == nil {
+			if minutes, ok := planned[sourceID]; ok {
+				result[sourceID] = minutes
+			} else {
+				delete(result, sourceID)
+			}
+			continue
+		}
+		// An auth failure fails every write the same way; report it once.
+		if client.IsUnauthorized(errs[i]) || client.IsForbidden(errs[i]) {
+			if !authReported {
+				authReported = addAuthDiagnostic(diagnostics, errs[i])
+			}
+			continue
+		}
+		entry := path.Root("minutes_thresholds").AtMapKey(sourceID)
+		switch {
+		case client.IsNotFound(errs[i]):
+			diagnostics.AddAttributeError(entry, "Log Source Not Found",
+				fmt.Sprintf("No log source has ID %q. Fix the ID or remove the entry.", sourceID))
+		default:
+			diagnostics.AddAttributeError(entry, "Error Writing Log Source Alarm",
+				fmt.Sprintf("Could not write the %s alarm of log source %q: %s", AlarmTypeSourceNoData, sourceID, errs[i]))
+		}
+	}
+	return result
+}
+
+func noDataThresholds(ctx context.Context, m types.Map, diagnostics *diag.Diagnostics) map[string]int64 {
+	thresholds := map[string]int64{}
+	if !m.IsNull() && !m.IsUnknown() {
+		diagnostics.Append(m.ElementsAs(ctx, &thresholds, false)...)
+	}
+	return thresholds
+}
+
+func noDataThresholdsMap(ctx context.Context, thresholds map[string]int64, diagnostics *diag.Diagnostics) types.Map {
+	result, d := types.MapValueFrom(ctx, types.Int64Type, thresholds)
+	diagnostics.Append(d...)
+	return result
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-panther/internal/client"
+)
+
+// TestLogSourceAlarmsResource manages the no-data alarms of three httpsource parents as
+// one set: create, import of every existing alarm, an update that adds, changes and
+// removes entries in one apply, drift, and recovery. Like TestLogSourceAlarmResource, the
+// parents are deleted out of band at the end to sidestep the Firehose teardown race,
+// which takes their alarms with them and leaves destroy a no-op.
+func TestLogSourceAlarmsResource(t *testing.T) {
+	t.Parallel()
+	parentLabel := strings.ReplaceAll(uuid.NewString(), "-", "")
+	// thresholds holds one threshold per parent; 0 leaves the parent out of the set.
+	config := func(thresholds ...int64) string {
+		return providerConfig + fmt.Sprintf(`
+locals {
+  thresholds = [%d, %d, %d]
+}
+
+resource "panther_httpsource" "parent" {
+  count             = 3
+  integration_label = "%s-${count.index}"
+  log_stream_type   = "Auto"
+  log_types         = ["AWS.CloudFrontAccess"]
+  auth_method       = "SharedSecret"
+  auth_header_key   = "x-api-key"
+  auth_secret_value = "test-secret-value"
+}
+
+resource "panther_log_source_alarms" "test" {
+  minutes_thresholds = {
+    for i, source in panther_httpsource.parent : source.id => local.thresholds[i] if local.thresholds[i] > 0
+  }
+}
+`, thresholds[0], thresholds[1], thresholds[2], parentLabel)
+	}
+	const address = "panther_log_source_alarms.test"
+	// The import check only sees the imported state, so step 1 records the parent IDs.
+	var parentIDs []string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(60, 120, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(address, "id", "SOURCE_NO_DATA"),
+					resource.TestCheckResourceAttr(address, "minutes_thresholds.%", "2"),
+					checkNoDataThresholds(60, 120, 0),
+					recordHTTPSourceParentIDs(&parentIDs, 3),
+				),
+			},
+			{
+				// Other tests add alarms in parallel, so the import adopts more than this
+				// test's parents; check only those.
+				ResourceName:  address,
+				ImportState:   true,
+				ImportStateId: "SOURCE_NO_DATA",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					return checkImportedNoDataThresholds(states, map[string]string{parentIDs[0]: "60", parentIDs[1]: "120"})
+				},
+			},
+			{
+				ResourceName:  address,
+				ImportState:   true,
+				ImportStateId: "SOURCE_PERMISSIONS_CHECKS",
+				ExpectError:   invalidImportIDRegex,
+			},
+			{
+				Config: config(15, 0, 43200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(address, "minutes_thresholds.%", "2"),
+					checkNoDataThresholds(15, 0, 43200),
+				),
+			},
+			{
+				Config:             config(15, 0, 43200),
+				Check:              manuallyDeleteNoDataAlarm(0),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config(15, 0, 43200),
+				Check:  checkNoDataThresholds(15, 0, 43200),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					manuallyDeleteSource(t, "panther_httpsource.parent.0", httpSourcePath),
+					manuallyDeleteSource(t, "panther_httpsource.parent.1", httpSourcePath),
+					manuallyDeleteSource(t, "panther_httpsource.parent.2", httpSourcePath),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// TestLogSourceAlarmsResource_UnknownSources verifies a failed write is reported against
+// its own map entry, naming the source. No parent needed.
+func TestLogSourceAlarmsResource_UnknownSources(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "panther_log_source_alarms" "test" {
+  minutes_thresholds = {
+    "ffffffff-ffff-4fff-bfff-ffffffffffff" = 60
+    "eeeeeeee-eeee-4eee-beee-eeeeeeeeeeee" = 60
+  }
+}
+`,
+				// Each error points at its own line of the map.
+				ExpectError: regexp.MustCompile(`(?s)"ffffffff-ffff-4fff-bfff-ffffffffffff" = 60\s+No log source has ID\s+"ffffffff-ffff-4fff-bfff-ffffffffffff".*` +
+					`"eeeeeeee-eeee-4eee-beee-eeeeeeeeeeee" = 60\s+No log source has ID\s+"eeeeeeee-eeee-4eee-beee-eeeeeeeeeeee"`),
+			},
+		},
+	})
+}
+
+// TestLogSourceAlarmsResource_PlanTimeValidation covers the map validators. No API call.
+func TestLogSourceAlarmsResource_PlanTimeValidation(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name        string
+		thresholds  string
+		expectError string
+	}{
+		{"threshold_below_15", `{ "00000000-0000-0000-0000-000000000000" = 14 }`, `between 15 and 43200`},
+		{"threshold_above_43200", `{ "00000000-0000-0000-0000-000000000000" = 43201 }`, `between 15 and 43200`},
+		{"empty_map", `{}`, `at least 1`},
+		{"empty_source_id", `{ "" = 60 }`, `string\s+length\s+must\s+be\s+at\s+least\s+1`},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_log_source_alarms" "test" {
+  minutes_thresholds = %s
+}
+`, tc.thresholds),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.expectError),
+					},
+				},
+			})
+		})
+	}
+}
+
+// checkNoDataThresholds reads each parent's no-data alarm from the API and compares it
+// with the expected threshold; 0 expects no alarm.
+func checkNoDataThresholds(thresholds ...int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := client.NewRESTClient(os.Getenv("PANTHER_API_URL"), os.Getenv("PANTHER_API_TOKEN"), testUserAgent)
+		for i, want := range thresholds {
+			sourceID, err := httpSourceParentID(s, i)
+			if err != nil {
+				return err
+			}
+			alarm, err := client.RestDo[client.LogSourceAlarm](context.Background(), c, http.MethodGet, alarmPath(sourceID, AlarmTypeSourceNoData), nil)
+			switch {
+			case want == 0 && client.IsNotFound(err):
+				continue
+			case want == 0 && err == nil:
+				return fmt.Errorf("source %s still has a no-data alarm", sourceID)
+			case err != nil:
+				return fmt.Errorf("could not read the no-data alarm of source %s: %w", sourceID, err)
+			case alarm.MinutesThreshold != want:
+				return fmt.Errorf("source %s: expected minutes_threshold %d, got %d", sourceID, want, alarm.MinutesThreshold)
+			}
+		}
+		return nil
+	}
+}
+
+// checkImportedNoDataThresholds verifies the imported map holds the expected threshold
+// for each source ID.
+func checkImportedNoDataThresholds(states []*terraform.InstanceState, thresholds map[string]string) error {
+	if len(states) != 1 {
+		return fmt.Errorf("expected 1 imported state, got %d", len(states))
+	}
+	attributes := states[0].Attributes
+	if attributes["id"] != AlarmTypeSourceNoData {
+		return fmt.Errorf("expected id %q, got %q", AlarmTypeSourceNoData, attributes["id"])
+	}
+	for sourceID, want := range thresholds {
+		if got := attributes["minutes_thresholds."+sourceID]; got != want {
+			return fmt.Errorf("source %s: expected imported minutes_threshold %s, got %q", sourceID, want, got)
+		}
+	}
+	return nil
+}
+
+// recordHTTPSourceParentIDs stores the IDs of the first count httpsource parents.
+func recordHTTPSourceParentIDs(ids *[]string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		*ids = nil
+		for i := 0; i < count; i++ {
+			sourceID, err := httpSourceParentID(s, i)
+			if err != nil {
+				return err
+			}
+			*ids = append(*ids, sourceID)
+		}
+		return nil
+	}
+}
+
+// manuallyDeleteNoDataAlarm deletes a parent's no-data alarm behind Terraform's back.
+func manuallyDeleteNoDataAlarm(parent int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		sourceID, err := httpSourceParentID(s, parent)
+		if err != nil {
+			return err
+		}
+		c := client.NewRESTClient(os.Getenv("PANTHER_API_URL"), os.Getenv("PANTHER_API_TOKEN"), testUserAgent)
+		if err := client.RestDelete(context.Background(), c, alarmPath(sourceID, AlarmTypeSourceNoData)); err != nil {
+			return fmt.Errorf("could not delete the no-data alarm of source %s: %w", sourceID, err)
+		}
+		return nil
+	}
+}
+
+func httpSourceParentID(s *terraform.State, index int) (string, error) {
+	address := fmt.Sprintf("panther_httpsource.parent.%d", index)
+	rs, ok := s.RootModule().Resources[address]
+	if !ok || rs.Primary.ID == "" {
+		return "", fmt.Errorf("%s ID is not set", address)
+	}
+	return rs.Primary.ID, nil
+}
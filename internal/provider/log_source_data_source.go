@@ -0,0 +1,171 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Shared plumbing for the log source data sources (panther_s3_source,
+// panther_httpsource, panther_gcssource, panther_pubsubsource). Each one is
+// looked up either by `id` or by its label attribute (`name` for S3, which
+// predates the REST migration, `integration_label` for the rest), and exposes
+// the same attributes as its resource minus write-only secrets.
+
+var logStreamTypeOptionAttrTypes = map[string]attr.Type{
+	"json_array_envelope_field": types.StringType,
+	"xml_root_element":          types.StringType,
+}
+
+// logSourceLookupAttributes returns the id and label attributes. Both are
+// Optional+Computed: whichever one isn't configured is filled in from the API.
+func logSourceLookupAttributes(sourceName, labelAttr string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: fmt.Sprintf("The ID of the %s. Exactly one of id or %s must be set.", sourceName, labelAttr),
+		},
+		labelAttr: schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: fmt.Sprintf("The integration label (name) of the %s. Exactly one of id or %s must be set.", sourceName, labelAttr),
+		},
+	}
+}
+
+func logSourceLookupValidators(labelAttr string) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot(labelAttr)),
+	}
+}
+
+// lookupLogSource fetches the log source by ID when one is configured; otherwise
+// it lists every source under basePath and returns the one whose label matches.
+// Returns false if a diagnostic was added (caller should return).
+func lookupLogSource[T any](ctx context.Context, rest *client.RESTClient, basePath, sourceName, labelAttr string,
+	id, label types.String, labelOf func(T) string, diagnostics *diag.Diagnostics) (T, bool) {
+	var zero T
+	if !id.IsNull() {
+		lookup := fmt.Sprintf("id %q", id.ValueString())
+		source, err := client.RestDo[T](ctx, rest, http.MethodGet, basePath+"/"+id.ValueString(), nil)
+		if handleDataSourceReadError(diagnostics, sourceName, lookup, err) {
+			return zero, false
+		}
+		return source, true
+	}
+
+	lookup := fmt.Sprintf("%s %q", labelAttr, label.ValueString())
+	sources, err := client.RestList[T](ctx, rest, basePath)
+	if handleDataSourceReadError(diagnostics, sourceName, lookup, err) {
+		return zero, false
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Listed %ss", sourceName), map[string]any{"count": len(sources)})
+
+	var matches []T
+	for _, source := range sources {
+		if labelOf(source) == label.ValueString() {
+			matches = append(matches, source)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		diagnostics.AddError(
+			fmt.Sprintf("%s not found", sourceName),
+			fmt.Sprintf("No %s matches %s.", sourceName, lookup),
+		)
+		return zero, false
+	case 1:
+		return matches[0], true
+	default:
+		diagnostics.AddError(
+			fmt.Sprintf("Multiple %ss found", sourceName),
+			fmt.Sprintf("%d %ss match %s. Look the source up by id instead.", len(matches), sourceName, lookup),
+		)
+		return zero, false
+	}
+}
+
+func logStreamTypeOptionsDataSourceAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: "Options specific to the log stream type. Null when none are set.",
+		Attributes: map[string]schema.Attribute{
+			"json_array_envelope_field": schema.StringAttribute{
+				Computed:    true,
+				Description: "Path to the array value to extract elements from, only applicable if log_stream_type is JsonArray.",
+			},
+			"xml_root_element": schema.StringAttribute{
+				Computed:    true,
+				Description: "The root element name for XML streams, only applicable if log_stream_type is XML.",
+			},
+		},
+	}
+}
+
+func logStreamTypeOptionsToObject(jsonArrayEnvelopeField, xmlRootElement string) types.Object {
+	return types.ObjectValueMust(logStreamTypeOptionAttrTypes, map[string]attr.Value{
+		"json_array_envelope_field": types.StringValue(jsonArrayEnvelopeField),
+		"xml_root_element":          types.StringValue(xmlRootElement),
+	})
+}
+
+func prefixLogTypesDataSourceAttribute(prefixDescription string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "The configured mapping of prefixes to log types.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"excluded_prefixes": schema.ListAttribute{
+					ElementType: types.StringType,
+					Computed:    true,
+					Description: "Prefixes excluded from log type mapping.",
+				},
+				"log_types": schema.ListAttribute{
+					ElementType: types.StringType,
+					Computed:    true,
+					Description: "The log types (schemas) applied to the prefix.",
+				},
+				"prefix": schema.StringAttribute{
+					Computed:    true,
+					Description: prefixDescription,
+				},
+			},
+		},
+	}
+}
+
+func stringValues(values []string) []types.String {
+	result := make([]types.String, 0, len(values))
+	for _, v := range values {
+		result = append(result, types.StringValue(v))
+	}
+	return result
+}
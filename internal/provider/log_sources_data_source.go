@@ -0,0 +1,315 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = (*logSourcesDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*logSourcesDataSource)(nil)
+)
+
+// logSourceKind is one log source type the API lists under its own path.
+// List fetches every source of that type and reduces each to a summary.
+type logSourceKind struct {
+	Type string
+	Name string
+	List func(ctx context.Context, rest *client.RESTClient) ([]logSourceSummaryModel, error)
+}
+
+// logSourceKinds are listed in this order; add new source types here.
+var logSourceKinds = []logSourceKind{
+	{Type: "s3", Name: "S3 Sources", List: func(ctx context.Context, rest *client.RESTClient) ([]logSourceSummaryModel, error) {
+		return listLogSourceSummaries(ctx, rest, s3SourcePath, "s3", func(s client.S3Source) (string, string, []string) {
+			var logTypes []string
+			for _, p := range s.S3PrefixLogTypes {
+				logTypes = append(logTypes, p.LogTypes...)
+			}
+			return s.IntegrationId, s.IntegrationLabel, logTypes
+		})
+	}},
+	{Type: "http", Name: "HTTP Sources", List: func(ctx context.Context, rest *client.RESTClient) ([]logSourceSummaryModel, error) {
+		return listLogSourceSummaries(ctx, rest, httpSourcePath, "http", func(s client.HttpSource) (string, string, []string) {
+			return s.IntegrationId, s.IntegrationLabel, s.LogTypes
+		})
+	}},
+	{Type: "gcs", Name: "GCS Sources", List: func(ctx context.Context, rest *client.RESTClient) ([]logSourceSummaryModel, error) {
+		return listLogSourceSummaries(ctx, rest, gcsSourcePath, "gcs", func(s client.GcsSource) (string, string, []string) {
+			var logTypes []string
+			for _, p := range s.PrefixLogTypes {
+				logTypes = append(logTypes, p.LogTypes...)
+			}
+			return s.IntegrationId, s.IntegrationLabel, logTypes
+		})
+	}},
+	{Type: "pubsub", Name: "Pub/Sub Sources", List: func(ctx context.Context, rest *client.RESTClient) ([]logSourceSummaryModel, error) {
+		return listLogSourceSummaries(ctx, rest, pubsubSourcePath, "pubsub", func(s client.PubSubSource) (string, string, []string) {
+			return s.IntegrationId, s.IntegrationLabel, s.LogTypes
+		})
+	}},
+	{Type: "azure-blob", Name: "Azure Blob Storage Sources", List: func(ctx context.Context, rest *client.RESTClient) ([]logSourceSummaryModel, error) {
+		return listLogSourceSummaries(ctx, rest, azureBlobSourcePath, "azure-blob", func(s client.AzureBlobSource) (string, string, []string) {
+			var logTypes []string
+			for _, p := range s.PrefixLogTypes {
+				logTypes = append(logTypes, p.LogTypes...)
+			}
+			return s.IntegrationId, s.IntegrationLabel, logTypes
+		})
+	}},
+	{Type: "azure-eventhub", Name: "Azure Event Hub Sources", List: func(ctx context.Context, rest *client.RESTClient) ([]logSourceSummaryModel, error) {
+		return listLogSourceSummaries(ctx, rest, azureEventHubSourcePath, "azure-eventhub", func(s client.AzureEventHubSource) (string, string, []string) {
+			return s.IntegrationId, s.IntegrationLabel, s.LogTypes
+		})
+	}},
+	{Type: "sqs", Name: "SQS Sources", List: func(ctx context.Context, rest *client.RESTClient) ([]logSourceSummaryModel, error) {
+		return listLogSourceSummaries(ctx, rest, sqsSourcePath, "sqs", func(s client.SqsSource) (string, string, []string) {
+			return s.IntegrationId, s.IntegrationLabel, s.LogTypes
+		})
+	}},
+	{Type: "cloudwatch", Name: "CloudWatch Logs Sources", List: func(ctx context.Context, rest *client.RESTClient) ([]logSourceSummaryModel, error) {
+		return listLogSourceSummaries(ctx, rest, cloudWatchSourcePath, "cloudwatch", func(s client.CloudWatchSource) (string, string, []string) {
+			return s.IntegrationId, s.IntegrationLabel, s.LogTypes
+		})
+	}},
+	{Type: "eventbridge", Name: "EventBridge Sources", List: func(ctx context.Context, rest *client.RESTClient) ([]logSourceSummaryModel, error) {
+		return listLogSourceSummaries(ctx, rest, eventBridgeSourcePath, "eventbridge", func(s client.EventBridgeSource) (string, string, []string) {
+			return s.IntegrationId, s.IntegrationLabel, s.LogTypes
+		})
+	}},
+	{Type: "okta", Name: "Okta Sources", List: func(ctx context.Context, rest *client.RESTClient) ([]logSourceSummaryModel, error) {
+		return listLogSourceSummaries(ctx, rest, oktaSourcePath, "okta", func(s client.OktaSource) (string, string, []string) {
+			return s.IntegrationId, s.IntegrationLabel, s.LogTypes
+		})
+	}},
+	{Type: "github", Name: "GitHub Sources", List: func(ctx context.Context, rest *client.RESTClient) ([]logSourceSummaryModel, error) {
+		return listLogSourceSummaries(ctx, rest, gitHubSourcePath, "github", func(s client.GitHubSource) (string, string, []string) {
+			return s.IntegrationId, s.IntegrationLabel, s.LogTypes
+		})
+	}},
+	{Type: "slack", Name: "Slack Sources", List: func(ctx context.Context, rest *client.RESTClient) ([]logSourceSummaryModel, error) {
+		return listLogSourceSummaries(ctx, rest, slackSourcePath, "slack", func(s client.SlackSource) (string, string, []string) {
+			return s.IntegrationId, s.IntegrationLabel, s.LogTypes
+		})
+	}},
+	{Type: "google-workspace", Name: "Google Workspace Sources", List: func(ctx context.Context, rest *client.RESTClient) ([]logSourceSummaryModel, error) {
+		return listLogSourceSummaries(ctx, rest, googleWorkspaceSourcePath, "google-workspace", func(s client.GoogleWorkspaceSource) (string, string, []string) {
+			return s.IntegrationId, s.IntegrationLabel, s.LogTypes
+		})
+	}},
+	{Type: "onepassword", Name: "1Password Sources", List: func(ctx context.Context, rest *client.RESTClient) ([]logSourceSummaryModel, error) {
+		return listLogSourceSummaries(ctx, rest, onePasswordSourcePath, "onepassword", func(s client.OnePasswordSource) (string, string, []string) {
+			return s.IntegrationId, s.IntegrationLabel, s.LogTypes
+		})
+	}},
+	{Type: "microsoft365", Name: "Microsoft 365 Sources", List: func(ctx context.Context, rest *client.RESTClient) ([]logSourceSummaryModel, error) {
+		return listLogSourceSummaries(ctx, rest, microsoft365SourcePath, "microsoft365", func(s client.Microsoft365Source) (string, string, []string) {
+			return s.IntegrationId, s.IntegrationLabel, s.LogTypes
+		})
+	}},
+}
+
+// listLogSourceSummaries lists every source under basePath. fields returns the
+// id, label and log types of a source; duplicate log types (the same type mapped
+// to several prefixes) are collapsed and the result sorted.
+func listLogSourceSummaries[T any](ctx context.Context, rest *client.RESTClient, basePath, sourceType string,
+	fields func(T) (string, string, []string)) ([]logSourceSummaryModel, error) {
+	sources, err := client.RestList[T](ctx, rest, basePath)
+	if err != nil {
+		return nil, err
+	}
+	summaries := make([]logSourceSummaryModel, 0, len(sources))
+	for _, source := range sources {
+		id, label, logTypes := fields(source)
+		logTypes = slices.Clone(logTypes)
+		slices.Sort(logTypes)
+		summaries = append(summaries, logSourceSummaryModel{
+			Id:       types.StringValue(id),
+			Label:    types.StringValue(label),
+			Type:     types.StringValue(sourceType),
+			LogTypes: stringValues(slices.Compact(logTypes)),
+		})
+	}
+	return summaries, nil
+}
+
+func NewLogSourcesDataSource() datasource.DataSource {
+	return &logSourcesDataSource{}
+}
+
+type logSourcesDataSource struct {
+	rest *client.RESTClient
+}
+
+type logSourcesDataSourceModel struct {
+	Type       types.String            `tfsdk:"type"`
+	LabelRegex types.String            `tfsdk:"label_regex"`
+	LogType    types.String            `tfsdk:"log_type"`
+	LogSources []logSourceSummaryModel `tfsdk:"log_sources"`
+}
+
+type logSourceSummaryModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Label    types.String   `tfsdk:"label"`
+	Type     types.String   `tfsdk:"type"`
+	LogTypes []types.String `tfsdk:"log_types"`
+}
+
+func (d *logSourcesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_log_sources"
+}
+
+func (d *logSourcesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	sourceTypes := make([]string, 0, len(logSourceKinds))
+	for _, kind := range logSourceKinds {
+		sourceTypes = append(sourceTypes, kind.Type)
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the log sources in the Panther instance, whether or not Terraform manages them. " +
+			"All filters are optional and combine with AND.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Only list log sources of this type. One of: %v.", sourceTypes),
+				Validators:  []validator.String{stringvalidator.OneOf(sourceTypes...)},
+			},
+			"label_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list log sources whose label matches this regular expression (RE2 syntax, unanchored).",
+				Validators:  []validator.String{compilesAsRegex{}},
+			},
+			"log_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list log sources that ingest this log type.",
+			},
+			"log_sources": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching log sources, ordered by type and then label.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the log source.",
+						},
+						"label": schema.StringAttribute{
+							Computed:    true,
+							Description: "The integration label (name) of the log source.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The log source type.",
+						},
+						"log_types": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "The log types the source ingests, across all of its prefixes.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *logSourcesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.rest = dataSourceRESTClient(req, resp)
+}
+
+func (d *logSourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data logSourcesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// label_regex is checked by compilesAsRegex at validation time.
+	var labelRegex *regexp.Regexp
+	if !data.LabelRegex.IsNull() {
+		labelRegex = regexp.MustCompile(data.LabelRegex.ValueString())
+	}
+
+	summaries := listLogSources(ctx, d.rest, data.Type.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.LogSources = []logSourceSummaryModel{}
+	for _, summary := range summaries {
+		if labelRegex != nil && !labelRegex.MatchString(summary.Label.ValueString()) {
+			continue
+		}
+		if !data.LogType.IsNull() && !slices.Contains(summary.LogTypes, data.LogType) {
+			continue
+		}
+		data.LogSources = append(data.LogSources, summary)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listLogSources lists the sources of every kind, or only of sourceType when it isn't
+// empty, ordered by kind and then label. A kind whose endpoint returns 404 isn't
+// available on the instance (e.g. an older release or a feature that isn't enabled)
+// and is skipped; a warning is added only if it was asked for by type.
+func listLogSources(ctx context.Context, rest *client.RESTClient, sourceType string, diagnostics *diag.Diagnostics) []logSourceSummaryModel {
+	var result []logSourceSummaryModel
+	for _, kind := range logSourceKinds {
+		if sourceType != "" && sourceType != kind.Type {
+			continue
+		}
+		summaries, err := kind.List(ctx, rest)
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, fmt.Sprintf("Skipped %s: not available on this instance", kind.Name))
+			if sourceType != "" {
+				diagnostics.AddWarning(
+					fmt.Sprintf("%s not available", kind.Name),
+					fmt.Sprintf("The Panther instance doesn't support log sources of type %q, so none are listed.\n\nAPI error: %s", kind.Type, err),
+				)
+			}
+			continue
+		}
+		if err != nil {
+			if !addAuthDiagnostic(diagnostics, err) {
+				diagnostics.AddError(
+					fmt.Sprintf("Error listing %s", kind.Name),
+					fmt.Sprintf("Could not list log sources of type %q: %s", kind.Type, err),
+				)
+			}
+			return nil
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Listed %s", kind.Name), map[string]any{"count": len(summaries)})
+
+		sort.SliceStable(summaries, func(i, j int) bool {
+			return summaries[i].Label.ValueString() < summaries[j].Label.ValueString()
+		})
+		result = append(result, summaries...)
+	}
+	return result
+}
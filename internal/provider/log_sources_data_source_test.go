@@ -0,0 +1,150 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-panther/internal/client"
+	"terraform-provider-panther/internal/client/fake"
+)
+
+// TestLogSourcesDataSource lists the HTTP source created in the same config through
+// each filter. Other sources in the instance are filtered out by the label regex.
+func TestLogSourcesDataSource(t *testing.T) {
+	integrationLabel := strings.ReplaceAll(uuid.NewString(), "-", "")
+	lookups := `
+data "panther_log_sources" "by_label" {
+  label_regex = "^${panther_httpsource.test.integration_label}$"
+}
+
+data "panther_log_sources" "by_type_and_log_type" {
+  type        = "http"
+  label_regex = panther_httpsource.test.integration_label
+  log_type    = "Zscaler.ZIA.WebLog"
+}
+
+data "panther_log_sources" "wrong_log_type" {
+  label_regex = panther_httpsource.test.integration_label
+  log_type    = "AWS.CloudTrail"
+}
+
+data "panther_log_sources" "wrong_type" {
+  type        = "gcs"
+  label_regex = panther_httpsource.test.integration_label
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testUpdatedHttpSourceResourceConfig(integrationLabel) + lookups,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.panther_log_sources.by_label", "log_sources.#", "1"),
+					resource.TestCheckResourceAttrPair("data.panther_log_sources.by_label", "log_sources.0.id", "panther_httpsource.test", "id"),
+					resource.TestCheckResourceAttr("data.panther_log_sources.by_label", "log_sources.0.label", integrationLabel),
+					resource.TestCheckResourceAttr("data.panther_log_sources.by_label", "log_sources.0.type", "http"),
+					resource.TestCheckResourceAttr("data.panther_log_sources.by_label", "log_sources.0.log_types.#", "1"),
+					resource.TestCheckResourceAttr("data.panther_log_sources.by_label", "log_sources.0.log_types.0", "Zscaler.ZIA.WebLog"),
+					resource.TestCheckResourceAttr("data.panther_log_sources.by_type_and_log_type", "log_sources.#", "1"),
+					resource.TestCheckResourceAttr("data.panther_log_sources.wrong_log_type", "log_sources.#", "0"),
+					resource.TestCheckResourceAttr("data.panther_log_sources.wrong_type", "log_sources.#", "0"),
+				),
+			},
+			{
+				Config:             providerConfig + testUpdatedHttpSourceResourceConfig(integrationLabel),
+				Check:              manuallyDeleteSource(t, "panther_httpsource.test", httpSourcePath),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// TestLogSourcesDataSource_InvalidFilters covers the filters rejected before any
+// source is returned.
+func TestLogSourcesDataSource_InvalidFilters(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "panther_log_sources" "test" {
+  label_regex = "("
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid regular expression`),
+			},
+			{
+				Config: providerConfig + `
+data "panther_log_sources" "test" {
+  type = "kafka"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+// TestListLogSources_SkipsUnavailableKinds serves the fake API with the Okta endpoint
+// removed, as on an instance without it: the other kinds are still listed, and only a
+// lookup by type "okta" warns.
+func TestListLogSources_SkipsUnavailableKinds(t *testing.T) {
+	srv := fake.NewServer("token")
+	defer srv.Close()
+	withoutOkta := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, oktaSourcePath) {
+			http.NotFound(w, r)
+			return
+		}
+		srv.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer withoutOkta.Close()
+
+	ctx := context.Background()
+	rest := client.NewRESTClient(withoutOkta.URL, "token", testUserAgent)
+	input := client.HttpSourceInput{IntegrationLabel: "listed", LogStreamType: "JSON", LogTypes: []string{"AWS.CloudTrail"}, AuthMethod: "None"}
+	if _, err := client.RestDo[client.HttpSource](ctx, rest, http.MethodPost, httpSourcePath, input); err != nil {
+		t.Fatalf("creating HTTP source: %v", err)
+	}
+
+	var diags diag.Diagnostics
+	summaries := listLogSources(ctx, rest, "", &diags)
+	if diags.HasError() || diags.WarningsCount() != 0 {
+		t.Fatalf("listing all kinds: unexpected diagnostics %v", diags)
+	}
+	if len(summaries) != 1 || summaries[0].Label.ValueString() != "listed" {
+		t.Fatalf("expected only the HTTP source, got %v", summaries)
+	}
+
+	diags = nil
+	if summaries := listLogSources(ctx, rest, "okta", &diags); len(summaries) != 0 {
+		t.Fatalf("expected no Okta sources, got %v", summaries)
+	}
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("listing okta: expected a single warning, got %v", diags)
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Where each source resource keeps its log type lists: http, pubsub, sqs, cloudwatch,
+// eventbridge and Azure Event Hub at the top level, s3, gcs and Azure Blob Storage per
+// prefix.
+var (
+	logTypesExpression       = path.MatchRoot("log_types")
+	prefixLogTypesExpression = path.MatchRoot("prefix_log_types").AtAnyListIndex().AtName("log_types")
+)
+
+// customLogTypePrefix starts the name of every custom log type (panther_schema).
+const customLogTypePrefix = "Custom."
+
+type plannedLogType struct {
+	path path.Path
+	name string
+}
+
+// validateLogTypes checks the planned log types at the given paths against the
+// instance's schema catalog, so a typo fails the plan with a suggestion instead of
+// failing (possibly half-way) at apply. A path may hold a list of log types or a single
+// one. It runs from ModifyPlan because the catalog needs the configured client;
+// ValidateResourceConfig runs before the provider is configured. Values unchanged from
+// state are not rechecked, so a schema archived after the resource was created doesn't
+// block unrelated changes. If the catalog can't be listed the check is skipped with a
+// warning. Unknown Custom.* names only warn; see unknownLogTypeDiagnostic.
+func validateLogTypes(ctx context.Context, rest *client.RESTClient, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, expressions ...path.Expression) {
+	if rest == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned []plannedLogType
+	for _, expression := range expressions {
+		paths, diags := req.Plan.PathMatches(ctx, expression)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		for _, p := range paths {
+			var value attr.Value
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, p, &value)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if value.IsNull() || value.IsUnknown() || logTypesUnchanged(ctx, req, p, value) {
+				continue
+			}
+			switch v := value.(type) {
+			case types.String:
+				planned = append(planned, plannedLogType{path: p, name: v.ValueString()})
+			case types.List:
+				for i, element := range v.Elements() {
+					name, ok := element.(types.String)
+					if ok && !name.IsNull() && !name.IsUnknown() {
+						planned = append(planned, plannedLogType{path: p.AtListIndex(i), name: name.ValueString()})
+					}
+				}
+			}
+		}
+	}
+	if len(planned) == 0 {
+		return
+	}
+
+	available, err := rest.LogTypes(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Log types not validated",
+			fmt.Sprintf("Could not list the instance's schemas, so log types will only be checked at apply: %s", err),
+		)
+		return
+	}
+	for _, lt := range planned {
+		if _, found := slices.BinarySearch(available, lt.name); found {
+			continue
+		}
+		resp.Diagnostics.Append(unknownLogTypeDiagnostic(lt, available))
+	}
+}
+
+// logTypesUnchanged reports whether the value at p is the same in the prior state.
+func logTypesUnchanged(ctx context.Context, req resource.ModifyPlanRequest, p path.Path, planned attr.Value) bool {
+	if req.State.Raw.IsNull() {
+		return false
+	}
+	var prior attr.Value
+	if diags := req.State.GetAttribute(ctx, p, &prior); diags.HasError() {
+		return false
+	}
+	return prior.Equal(planned)
+}
+
+// unknownLogTypeDiagnostic is an error, except for Custom.* names, which only warn: the
+// name may belong to a panther_schema planned in the same run. validateLogTypes only
+// knows about it if the schema's ModifyPlan ran first, which Terraform guarantees only
+// when the source references the schema (e.g. panther_schema.example.name).
+func unknownLogTypeDiagnostic(lt plannedLogType, available []string) diag.Diagnostic {
+	detail := fmt.Sprintf("Log type %q does not exist in this Panther instance.", lt.name)
+	suggestion := suggestLogType(lt.name, available)
+	if suggestion != "" {
+		detail += fmt.Sprintf(" Did you mean %q?", suggestion)
+	}
+	if strings.HasPrefix(lt.name, customLogTypePrefix) {
+		detail += " If its panther_schema is in this configuration, reference the schema's name " +
+			"(e.g. panther_schema.example.name) instead of repeating it, so the schema is created first. " +
+			"Otherwise the apply will fail."
+		return diag.NewAttributeWarningDiagnostic(lt.path, "Unknown Log Type", detail)
+	}
+	if suggestion == "" {
+		detail += fmt.Sprintf(" Custom log types are named %s<name>.", customLogTypePrefix)
+	}
+	return diag.NewAttributeErrorDiagnostic(lt.path, "Unknown Log Type", detail)
+}
+
+// suggestLogType returns the available log type closest to name, or "" if none is
+// close enough to be a plausible typo. Comparison ignores case, so a casing mistake
+// like AWS.Cloudtrail always finds AWS.CloudTrail.
+func suggestLogType(name string, available []string) string {
+	target := strings.ToLower(name)
+	best, bestDistance := "", len(target)/3+1
+	for _, candidate := range available {
+		if d := levenshtein(target, strings.ToLower(candidate)); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+// levenshtein is the edit distance between a and b, counted in bytes (log type
+// names are ASCII).
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestLogType(t *testing.T) {
+	available := []string{"AWS.ALB", "AWS.CloudTrail", "AWS.VPCFlow", "GCP.AuditLog", "Okta.SystemLog"}
+	cases := map[string]string{
+		"AWS.Cloudtrail":  "AWS.CloudTrail", // casing only
+		"AWS.CloudTrial":  "AWS.CloudTrail", // transposition
+		"GCP.AuditLogs":   "GCP.AuditLog",
+		"Okta.SysLog":     "Okta.SystemLog",
+		"Custom.Firewall": "",
+		"AWS.S3":          "",
+	}
+	for name, want := range cases {
+		assert.Equal(t, want, suggestLogType(name, available), name)
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	assert.Equal(t, 0, levenshtein("abc", "abc"))
+	assert.Equal(t, 3, levenshtein("", "abc"))
+	assert.Equal(t, 1, levenshtein("abc", "abd"))
+	assert.Equal(t, 2, levenshtein("trail", "trial"))
+}
+
+// TestLogTypeValidation_UnknownLogType checks that both log_types and
+// prefix_log_types[*].log_types are checked against the instance's schemas at plan
+// time, with a suggestion for near misses. PlanOnly: nothing is created.
+func TestLogTypeValidation_UnknownLogType(t *testing.T) {
+	cases := []struct {
+		name        string
+		config      string
+		expectError string
+	}{
+		{"http_typo", `
+resource "panther_httpsource" "test" {
+  integration_label = "log-type-validation"
+  log_stream_type   = "Auto"
+  log_types         = ["AWS.CloudTrail", "AWS.Cloudtrail"]
+  auth_method       = "None"
+}
+`, `(?s)"AWS.Cloudtrail" does not exist.*Did you\s+mean\s+"AWS.CloudTrail"\?`},
+		{"gcs_prefix_unknown", fmt.Sprintf(`
+resource "panther_gcssource" "test" {
+  integration_label = "log-type-validation"
+  subscription_id   = "sub"
+  project_id        = "project"
+  gcs_bucket        = "bucket"
+  credentials       = %q
+  credentials_type  = "service_account"
+  log_stream_type   = "Auto"
+  prefix_log_types = [{
+    prefix    = ""
+    log_types = ["Vendor.NotDeployed"]
+  }]
+}
+`, `{"type":"service_account","project_id":"project"}`), `(?s)"Vendor.NotDeployed" does not exist.*Custom\s+log\s+types\s+are\s+named\s+Custom.<name>`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      providerConfig + tc.config,
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.expectError),
+					},
+				},
+			})
+		})
+	}
+}
+
+// TestUnknownLogTypeDiagnostic checks that only Custom.* names, which may belong to a
+// schema planned in the same run, are let through with a warning.
+func TestUnknownLogTypeDiagnostic(t *testing.T) {
+	available := []string{"AWS.CloudTrail", "Custom.Firewall"}
+	cases := map[string]diag.Severity{
+		"AWS.Cloudtrail":   diag.SeverityError,
+		"Vendor.Unknown":   diag.SeverityError,
+		"Custom.Firewal":   diag.SeverityWarning,
+		"Custom.Inventory": diag.SeverityWarning,
+	}
+	for name, want := range cases {
+		d := unknownLogTypeDiagnostic(plannedLogType{path: path.Root("log_types"), name: name}, available)
+		assert.Equal(t, want, d.Severity(), name)
+	}
+}
+
+// TestLogTypeValidation_SchemaInSamePlan plans a panther_schema and a source that repeats
+// the schema's name instead of referencing it. Terraform may plan the two in either order,
+// so the source's check must not depend on the schema having been planned first.
+// PlanOnly: nothing is created.
+func TestLogTypeValidation_SchemaInSamePlan(t *testing.T) {
+	name := "Custom.SamePlan" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "panther_schema" "test" {
+  name = %[1]q
+  spec = <<-EOT
+    fields:
+      - name: message
+        type: string
+  EOT
+}
+
+resource "panther_httpsource" "test" {
+  integration_label = "log-type-validation"
+  log_stream_type   = "Auto"
+  log_types         = [%[1]q]
+  auth_method       = "None"
+}
+`, name),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
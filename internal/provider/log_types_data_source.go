@@ -0,0 +1,146 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = (*logTypesDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*logTypesDataSource)(nil)
+)
+
+func NewLogTypesDataSource() datasource.DataSource {
+	return &logTypesDataSource{}
+}
+
+type logTypesDataSource struct {
+	rest *client.RESTClient
+}
+
+type logTypesDataSourceModel struct {
+	Managed  types.Bool            `tfsdk:"managed"`
+	LogTypes []logTypeSummaryModel `tfsdk:"log_types"`
+	Names    []types.String        `tfsdk:"names"`
+}
+
+type logTypeSummaryModel struct {
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	ReferenceURL types.String `tfsdk:"reference_url"`
+	Managed      types.Bool   `tfsdk:"managed"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *logTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_log_types"
+}
+
+func (d *logTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the log types available in the Panther instance: the Panther-managed schemas and " +
+			"the custom schemas, enabled or not. Use `names` to check that a custom schema is deployed before a " +
+			"log source references it.",
+		Attributes: map[string]schema.Attribute{
+			"managed": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, only list Panther-managed log types; if false, only custom ones. Omit to list both.",
+			},
+			"log_types": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching log types, ordered by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The log type name, as referenced in a log source's `log_types`.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The schema description.",
+						},
+						"reference_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "A link to documentation for the log type.",
+						},
+						"managed": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the schema is managed by Panther rather than custom.",
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:    true,
+							Description: "False if the schema has been archived (disabled). Disabled log types can't be added to a log source.",
+						},
+					},
+				},
+			},
+			"names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The names of the enabled log types in `log_types`, ordered by name.",
+			},
+		},
+	}
+}
+
+func (d *logTypesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.rest = dataSourceRESTClient(req, resp)
+}
+
+func (d *logTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data logTypesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schemas, err := client.RestList[client.Schema](ctx, d.rest, client.SchemasPath)
+	if handleDataSourceReadError(&resp.Diagnostics, "Log Types", fmt.Sprintf("path %q", client.SchemasPath), err) {
+		return
+	}
+	tflog.Debug(ctx, "Listed Log Types", map[string]any{"count": len(schemas)})
+
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].Name < schemas[j].Name })
+	data.LogTypes = []logTypeSummaryModel{}
+	data.Names = []types.String{}
+	for _, s := range schemas {
+		if !data.Managed.IsNull() && data.Managed.ValueBool() != s.IsManaged {
+			continue
+		}
+		data.LogTypes = append(data.LogTypes, logTypeSummaryModel{
+			Name:         types.StringValue(s.Name),
+			Description:  types.StringValue(s.Description),
+			ReferenceURL: types.StringValue(s.ReferenceURL),
+			Managed:      types.BoolValue(s.IsManaged),
+			Enabled:      types.BoolValue(!s.IsArchived),
+		})
+		if !s.IsArchived {
+			data.Names = append(data.Names, types.StringValue(s.Name))
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestLogTypesDataSource lists the instance's log types with and without the managed
+// filter. It only asserts on managed log types, which every instance has.
+func TestLogTypesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "panther_log_types" "all" {}
+
+data "panther_log_types" "managed" {
+  managed = true
+}
+
+data "panther_log_types" "custom" {
+  managed = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.panther_log_types.all", "names.*", "AWS.CloudTrail"),
+					resource.TestCheckTypeSetElemAttr("data.panther_log_types.managed", "names.*", "AWS.CloudTrail"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.panther_log_types.managed", "log_types.*", map[string]string{
+						"name":    "AWS.CloudTrail",
+						"managed": "true",
+						"enabled": "true",
+					}),
+					testCheckLogTypesPartition("data.panther_log_types.all", "data.panther_log_types.managed", "data.panther_log_types.custom"),
+				),
+			},
+		},
+	})
+}
+
+// testCheckLogTypesPartition checks that the managed and custom listings split the
+// unfiltered one between them.
+func testCheckLogTypesPartition(all, managed, custom string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		count := func(name string) (int, error) {
+			rs, ok := s.RootModule().Resources[name]
+			if !ok {
+				return 0, fmt.Errorf("%s not found in state", name)
+			}
+			return strconv.Atoi(rs.Primary.Attributes["log_types.#"])
+		}
+		total, err := count(all)
+		if err != nil {
+			return err
+		}
+		m, err := count(managed)
+		if err != nil {
+			return err
+		}
+		c, err := count(custom)
+		if err != nil {
+			return err
+		}
+		if m+c != total || m == 0 {
+			return fmt.Errorf("expected managed (%d) + custom (%d) = all (%d), with at least one managed log type", m, c, total)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,489 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const lookupTablePath = "/lookup-tables"
+
+var (
+	lookupTableNameRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+	s3ObjectPathRegex    = regexp.MustCompile(`^s3://[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]/.+$`)
+)
+
+var (
+	lookupTableAssociationAttrTypes = map[string]attr.Type{
+		"log_type":  types.StringType,
+		"selectors": types.ListType{ElemType: types.StringType},
+	}
+	lookupTableS3SourceAttrTypes = map[string]attr.Type{
+		"object_path":            types.StringType,
+		"role_arn":               types.StringType,
+		"refresh_period_minutes": types.Int64Type,
+		"kms_key_arn":            types.StringType,
+	}
+	lookupTableInlineDataAttrTypes = map[string]attr.Type{
+		"file":   types.StringType,
+		"sha256": types.StringType,
+	}
+)
+
+var (
+	_ resource.Resource                     = (*lookupTableResource)(nil)
+	_ resource.ResourceWithConfigure        = (*lookupTableResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*lookupTableResource)(nil)
+	_ resource.ResourceWithImportState      = (*lookupTableResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*lookupTableResource)(nil)
+)
+
+func NewLookupTableResource() resource.Resource {
+	return &lookupTableResource{}
+}
+
+type lookupTableResource struct {
+	rest *client.RESTClient
+}
+
+type lookupTableModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	LogType            types.String `tfsdk:"log_type"`
+	PrimaryKey         types.String `tfsdk:"primary_key"`
+	AssociatedLogTypes types.List   `tfsdk:"associated_log_types"`
+	S3Source           types.Object `tfsdk:"s3_source"`
+	InlineData         types.Object `tfsdk:"inline_data"`
+}
+
+type lookupTableAssociationModel struct {
+	LogType   types.String `tfsdk:"log_type"`
+	Selectors types.List   `tfsdk:"selectors"`
+}
+
+type lookupTableS3SourceModel struct {
+	ObjectPath           types.String `tfsdk:"object_path"`
+	RoleArn              types.String `tfsdk:"role_arn"`
+	RefreshPeriodMinutes types.Int64  `tfsdk:"refresh_period_minutes"`
+	KmsKeyArn            types.String `tfsdk:"kms_key_arn"`
+}
+
+type lookupTableInlineDataModel struct {
+	File   types.String `tfsdk:"file"`
+	Sha256 types.String `tfsdk:"sha256"`
+}
+
+func (r *lookupTableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lookup_table"
+}
+
+func (r *lookupTableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Panther lookup table: reference data, keyed by `primary_key`, that enriches events " +
+			"of the associated log types. The data is either refreshed periodically from an S3 object (`s3_source`) " +
+			"or uploaded from a local file (`inline_data`), re-uploaded whenever the file's content changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The lookup table ID.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The lookup table name, unique in the instance. Detections reference the table by it.",
+				Validators: []validator.String{stringvalidator.RegexMatches(lookupTableNameRegex,
+					"must start with a letter and contain only letters, digits and underscores")},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "What the table contains.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether events are enriched with the table. Defaults to true.",
+			},
+			"log_type": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The log type (schema) describing the table's rows, e.g. a `panther_schema`. " +
+					"Checked against the instance's schemas at plan time.",
+			},
+			"primary_key": schema.StringAttribute{
+				Required:    true,
+				Description: "The field of log_type that identifies a row and is matched against the selectors.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"associated_log_types": schema.ListNestedAttribute{
+				Optional: true,
+				Computed: true,
+				Default: listdefault.StaticValue(types.ListValueMust(
+					types.ObjectType{AttrTypes: lookupTableAssociationAttrTypes}, []attr.Value{})),
+				Description: "The log types enriched with the table, and which of their fields hold the key to look up.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"log_type": schema.StringAttribute{
+							Required:    true,
+							Description: "The log type to enrich. Checked against the instance's schemas at plan time.",
+						},
+						"selectors": schema.ListAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							MarkdownDescription: "JSON paths of the event fields whose values are looked up " +
+								"(e.g. `$.sourceIPAddress`).",
+							Validators: []validator.List{listvalidator.SizeAtLeast(1)},
+						},
+					},
+				},
+			},
+			"s3_source": schema.SingleNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "Refresh the table from an S3 object. Exactly one of `s3_source` and " +
+					"`inline_data` must be set.",
+				Attributes: map[string]schema.Attribute{
+					"object_path": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The S3 URL of the object holding the data (e.g. `s3://bucket/path/data.csv`).",
+						Validators: []validator.String{stringvalidator.RegexMatches(s3ObjectPathRegex,
+							"must be an S3 object URL (e.g. s3://bucket/path/data.csv)")},
+					},
+					"role_arn": schema.StringAttribute{
+						Required:    true,
+						Description: "The AWS Role Panther assumes to read the object.",
+						Validators:  []validator.String{iamRoleARNValidator("PantherLogProcessingRole")},
+					},
+					"refresh_period_minutes": schema.Int64Attribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(60),
+						Description: "How often, in minutes, the object is re-read. Between 15 and 1440, defaults to 60.",
+						Validators:  []validator.Int64{int64validator.Between(15, 1440)},
+					},
+					"kms_key_arn": schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString(""),
+						Description: "The KMS key ARN used to decrypt the object, if it is encrypted with one.",
+						Validators:  []validator.String{kmsKeyARNValidator()},
+					},
+				},
+			},
+			"inline_data": schema.SingleNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "Upload the table's data from a local file. Exactly one of `s3_source` and " +
+					"`inline_data` must be set.",
+				Attributes: map[string]schema.Attribute{
+					"file": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Path to a JSON lines or CSV file with the table's rows, e.g. `${path.module}/data.csv`.",
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"sha256": schema.StringAttribute{
+						Computed: true,
+						Description: "The SHA-256 of the data loaded in Panther. A change to the file's content, " +
+							"or an upload made outside Terraform, shows up as a diff here.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *lookupTableResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(path.MatchRoot("s3_source"), path.MatchRoot("inline_data")),
+	}
+}
+
+func (r *lookupTableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+// ModifyPlan checks the log types, and plans inline_data.sha256 as the hash of the
+// file so that editing the file, not only renaming it, plans an upload.
+func (r *lookupTableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	validateLogTypes(ctx, r.rest, req, resp,
+		path.MatchRoot("log_type"),
+		path.MatchRoot("associated_log_types").AtAnyListIndex().AtName("log_type"))
+
+	var data lookupTableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	inline := data.inlineData(ctx, &resp.Diagnostics)
+	if inline == nil || inline.File.IsUnknown() {
+		return
+	}
+	content, err := os.ReadFile(inline.File.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("inline_data").AtName("file"),
+			"Unreadable Lookup Table Data", fmt.Sprintf("Could not read the lookup table's data: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("inline_data").AtName("sha256"), contentHash(content))...)
+}
+
+func (r *lookupTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data lookupTableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := data.toAPI(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	table, err := client.RestDo[client.LookupTable](ctx, r.rest, http.MethodPost, lookupTablePath, input)
+	if handleCreateError(resp, "Lookup Table", err) {
+		return
+	}
+	tflog.Debug(ctx, "Created Lookup Table", map[string]any{"id": table.ID})
+
+	if inline := data.inlineData(ctx, &resp.Diagnostics); inline != nil {
+		uploaded, err := r.upload(ctx, table.ID, *inline)
+		if err != nil {
+			// Keep the table in state so it isn't orphaned; its empty hash makes the
+			// next plan retry the upload.
+			resp.Diagnostics.AddError("Error uploading Lookup Table data", err.Error())
+		} else {
+			table = uploaded
+		}
+	}
+
+	data.set(ctx, table, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *lookupTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data lookupTableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	table, err := client.RestDo[client.LookupTable](ctx, r.rest, http.MethodGet, lookupTablePath+"/"+data.Id.ValueString(), nil)
+	if handleReadError(ctx, resp, "Lookup Table", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Read Lookup Table", map[string]any{"id": table.ID})
+
+	data.set(ctx, table, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *lookupTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data lookupTableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := data.toAPI(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	table, err := client.RestDo[client.LookupTable](ctx, r.rest, http.MethodPut, lookupTablePath+"/"+data.Id.ValueString(), input)
+	if handleUpdateError(ctx, resp, "Lookup Table", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated Lookup Table", map[string]any{"id": data.Id.ValueString()})
+
+	// Upload only when the data changed: a table can be large, and renaming or
+	// moving the file alone doesn't change what Panther holds.
+	planned := data.inlineData(ctx, &resp.Diagnostics)
+	if planned != nil && planned.Sha256.ValueString() != table.DataHash {
+		uploaded, err := r.upload(ctx, table.ID, *planned)
+		if err != nil {
+			// The table itself was updated; record that, with the old hash so the next
+			// plan retries the upload.
+			resp.Diagnostics.AddError("Error uploading Lookup Table data", err.Error())
+		} else {
+			table = uploaded
+		}
+	}
+
+	data.set(ctx, table, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *lookupTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data lookupTableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RestDelete(ctx, r.rest, lookupTablePath+"/"+data.Id.ValueString())
+	if handleDeleteError(resp, "Lookup Table", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Deleted Lookup Table", map[string]any{"id": data.Id.ValueString()})
+}
+
+// ImportState imports by ID. inline_data.file can't be recovered from the API; the
+// first apply after an import sets it from the configuration, uploading only if the
+// file's content differs from the imported data.
+func (r *lookupTableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// upload sends the inline_data file to Panther. It fails if the file changed since
+// the plan, which would otherwise store data the plan didn't show.
+func (r *lookupTableResource) upload(ctx context.Context, id string, inline lookupTableInlineDataModel) (client.LookupTable, error) {
+	content, err := os.ReadFile(inline.File.ValueString())
+	if err != nil {
+		return client.LookupTable{}, err
+	}
+	if hash := contentHash(content); hash != inline.Sha256.ValueString() {
+		return client.LookupTable{}, fmt.Errorf("%s changed after the plan was made (SHA-256 %s, planned %s); run the plan again",
+			inline.File.ValueString(), hash, inline.Sha256.ValueString())
+	}
+	table, err := client.RestDo[client.LookupTable](ctx, r.rest, http.MethodPut, lookupTablePath+"/"+id+"/data",
+		client.LookupTableDataInput{Data: string(content)})
+	if err != nil {
+		return client.LookupTable{}, err
+	}
+	tflog.Debug(ctx, "Uploaded Lookup Table data", map[string]any{"id": id, "sha256": table.DataHash})
+	return table, nil
+}
+
+func contentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func (m lookupTableModel) inlineData(ctx context.Context, diagnostics *diag.Diagnostics) *lookupTableInlineDataModel {
+	if m.InlineData.IsNull() || m.InlineData.IsUnknown() {
+		return nil
+	}
+	var inline lookupTableInlineDataModel
+	diagnostics.Append(m.InlineData.As(ctx, &inline, basetypes.ObjectAsOptions{})...)
+	return &inline
+}
+
+func (m lookupTableModel) toAPI(ctx context.Context, diagnostics *diag.Diagnostics) client.LookupTableInput {
+	input := client.LookupTableInput{
+		Name:               m.Name.ValueString(),
+		Description:        m.Description.ValueString(),
+		Enabled:            m.Enabled.ValueBool(),
+		LogType:            m.LogType.ValueString(),
+		PrimaryKey:         m.PrimaryKey.ValueString(),
+		AssociatedLogTypes: []client.LookupTableAssociation{},
+	}
+	var associations []lookupTableAssociationModel
+	diagnostics.Append(m.AssociatedLogTypes.ElementsAs(ctx, &associations, false)...)
+	for _, association := range associations {
+		input.AssociatedLogTypes = append(input.AssociatedLogTypes, client.LookupTableAssociation{
+			LogType:   association.LogType.ValueString(),
+			Selectors: listToStringSlice(ctx, association.Selectors, diagnostics),
+		})
+	}
+	if !m.S3Source.IsNull() {
+		var s3 lookupTableS3SourceModel
+		diagnostics.Append(m.S3Source.As(ctx, &s3, basetypes.ObjectAsOptions{})...)
+		input.Refresh = &client.LookupTableRefresh{
+			S3ObjectPath:  s3.ObjectPath.ValueString(),
+			RoleARN:       s3.RoleArn.ValueString(),
+			PeriodMinutes: s3.RefreshPeriodMinutes.ValueInt64(),
+			KMSKeyARN:     s3.KmsKeyArn.ValueString(),
+		}
+	}
+	return input
+}
+
+// set copies an API response into the model. A table without an S3 refresh takes its
+// data from uploads: inline_data keeps the configured file and records the hash of
+// the data Panther holds, so uploads made elsewhere show up as drift.
+func (m *lookupTableModel) set(ctx context.Context, table client.LookupTable, diagnostics *diag.Diagnostics) {
+	m.Id = types.StringValue(table.ID)
+	m.Name = types.StringValue(table.Name)
+	m.Description = types.StringValue(table.Description)
+	m.Enabled = types.BoolValue(table.Enabled)
+	m.LogType = types.StringValue(table.LogType)
+	m.PrimaryKey = types.StringValue(table.PrimaryKey)
+
+	associations := make([]lookupTableAssociationModel, 0, len(table.AssociatedLogTypes))
+	for _, association := range table.AssociatedLogTypes {
+		associations = append(associations, lookupTableAssociationModel{
+			LogType:   types.StringValue(association.LogType),
+			Selectors: stringSliceToList(ctx, nonNilStrings(association.Selectors), diagnostics),
+		})
+	}
+	var d diag.Diagnostics
+	m.AssociatedLogTypes, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: lookupTableAssociationAttrTypes}, associations)
+	diagnostics.Append(d...)
+
+	if table.Refresh != nil {
+		m.S3Source, d = types.ObjectValueFrom(ctx, lookupTableS3SourceAttrTypes, lookupTableS3SourceModel{
+			ObjectPath:           types.StringValue(table.Refresh.S3ObjectPath),
+			RoleArn:              types.StringValue(table.Refresh.RoleARN),
+			RefreshPeriodMinutes: types.Int64Value(table.Refresh.PeriodMinutes),
+			KmsKeyArn:            types.StringValue(table.Refresh.KMSKeyARN),
+		})
+		diagnostics.Append(d...)
+		m.InlineData = types.ObjectNull(lookupTableInlineDataAttrTypes)
+		return
+	}
+
+	file := types.StringNull()
+	if prior := m.inlineData(ctx, diagnostics); prior != nil {
+		file = prior.File
+	}
+	m.S3Source = types.ObjectNull(lookupTableS3SourceAttrTypes)
+	m.InlineData, d = types.ObjectValueFrom(ctx, lookupTableInlineDataAttrTypes, lookupTableInlineDataModel{
+		File:   file,
+		Sha256: types.StringValue(table.DataHash),
+	})
+	diagnostics.Append(d...)
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestLookupTableResource covers an inline table's lifecycle: create with an upload,
+// import, a content-only edit of the data file that must re-upload, and a switch to an
+// S3 refresh.
+func TestLookupTableResource(t *testing.T) {
+	suffix := strings.ReplaceAll(uuid.NewString(), "-", "")
+	schemaName := "Custom.AssetInventory" + suffix
+	name := "asset_inventory_" + suffix
+	dataFile := filepath.Join(t.TempDir(), "assets.jsonl")
+	writeData := func(content string) {
+		if err := os.WriteFile(dataFile, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	config := func(source string) string {
+		return providerConfig + testSchemaResourceConfig(schemaName, "", "fields:\n  - name: ip\n    type: string\n  - name: owner\n    type: string\n") +
+			fmt.Sprintf(`
+resource "panther_lookup_table" "test" {
+  name        = %q
+  log_type    = panther_schema.test.name
+  primary_key = "ip"
+  associated_log_types = [{
+    log_type  = "AWS.CloudTrail"
+    selectors = ["$.sourceIPAddress"]
+  }]
+%s
+}
+`, name, source)
+	}
+	inline := fmt.Sprintf("  inline_data = { file = %q }", dataFile)
+	const v1 = `{"ip":"10.0.0.1","owner":"alice"}` + "\n"
+	const v2 = `{"ip":"10.0.0.1","owner":"bob"}` + "\n"
+
+	writeData(v1)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(inline),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("panther_lookup_table.test", "id"),
+					resource.TestCheckResourceAttr("panther_lookup_table.test", "log_type", schemaName),
+					resource.TestCheckResourceAttr("panther_lookup_table.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("panther_lookup_table.test", "associated_log_types.0.selectors.0", "$.sourceIPAddress"),
+					resource.TestCheckResourceAttr("panther_lookup_table.test", "inline_data.sha256", testSHA256(v1)),
+					resource.TestCheckNoResourceAttr("panther_lookup_table.test", "s3_source"),
+				),
+			},
+			{
+				ResourceName:      "panther_lookup_table.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The local path isn't known to the API.
+				ImportStateVerifyIgnore: []string{"inline_data.file"},
+			},
+			{
+				PreConfig: func() { writeData(v2) },
+				Config:    config(inline),
+				Check:     resource.TestCheckResourceAttr("panther_lookup_table.test", "inline_data.sha256", testSHA256(v2)),
+			},
+			{
+				Config: config(`
+  s3_source = {
+    object_path = "s3://fake-bucket/lookups/assets.jsonl"
+    role_arn    = "arn:aws:iam::123456789012:role/fake-lookup-reader"
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_lookup_table.test", "s3_source.object_path", "s3://fake-bucket/lookups/assets.jsonl"),
+					resource.TestCheckResourceAttr("panther_lookup_table.test", "s3_source.refresh_period_minutes", "60"),
+					resource.TestCheckResourceAttr("panther_lookup_table.test", "s3_source.kms_key_arn", ""),
+					resource.TestCheckNoResourceAttr("panther_lookup_table.test", "inline_data"),
+				),
+			},
+		},
+	})
+}
+
+// TestLookupTableResource_PlanTimeValidation covers errors raised before any API call.
+// A case without err must pass them; alias ARNs are valid KMS key references.
+func TestLookupTableResource_PlanTimeValidation(t *testing.T) {
+	const s3 = `
+  s3_source = {
+    object_path = "s3://fake-bucket/lookups/assets.jsonl"
+    role_arn    = "arn:aws:iam::123456789012:role/fake-lookup-reader"
+  }`
+	missing := filepath.Join(t.TempDir(), "missing.csv")
+	cases := map[string]struct {
+		logType string
+		source  string
+		err     string
+	}{
+		"no_source": {
+			logType: "AWS.CloudTrail",
+			err:     `(?s)Exactly one of these attributes must be configured.*s3_source,inline_data`,
+		},
+		"both_sources": {
+			logType: "AWS.CloudTrail",
+			source:  s3 + fmt.Sprintf("\n  inline_data = { file = %q }", missing),
+			err:     `(?s)Exactly one of these attributes must be configured.*s3_source,inline_data`,
+		},
+		"bad_object_path": {
+			logType: "AWS.CloudTrail",
+			source:  strings.Replace(s3, "s3://fake-bucket/", "https://fake-bucket/", 1),
+			err:     `must be an S3 object URL`,
+		},
+		"bad_role_arn": {
+			logType: "AWS.CloudTrail",
+			source:  strings.Replace(s3, ":role/", ":user/", 1),
+			err:     `must be a valid IAM role ARN`,
+		},
+		"bad_kms_key_arn": {
+			logType: "AWS.CloudTrail",
+			source:  strings.Replace(s3, "\n  }", "\n    kms_key_arn = \"arn:aws:s3:::fake-bucket\"\n  }", 1),
+			err:     `must be a valid KMS key or alias ARN`,
+		},
+		"kms_alias_arn": {
+			logType: "AWS.CloudTrail",
+			source:  strings.Replace(s3, "\n  }", "\n    kms_key_arn = \"arn:aws:kms:us-east-1:123456789012:alias/panther\"\n  }", 1),
+		},
+		"bad_refresh_period": {
+			logType: "AWS.CloudTrail",
+			source:  strings.Replace(s3, "\n  }", "\n    refresh_period_minutes = 5\n  }", 1),
+			err:     `(?s)refresh_period_minutes.*must be between 15 and 1440`,
+		},
+		"unknown_log_type": {
+			logType: "AWS.Cloudtrail",
+			source:  s3,
+			err:     `(?s)Unknown Log Type.*Did you\s+mean\s+"AWS.CloudTrail"`,
+		},
+		"missing_file": {
+			logType: "AWS.CloudTrail",
+			source:  fmt.Sprintf("  inline_data = { file = %q }", missing),
+			err:     `(?s)Unreadable Lookup Table Data.*no\s+such\s+file`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			step := resource.TestStep{
+				Config: providerConfig + fmt.Sprintf(`
+resource "panther_lookup_table" "test" {
+  name        = "plan_time_validation"
+  log_type    = %q
+  primary_key = "ip"
+%s
+}
+`, tc.logType, tc.source),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			}
+			if tc.err != "" {
+				step.ExpectError = regexp.MustCompile(tc.err)
+			}
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps:                    []resource.TestStep{step},
+			})
+		})
+	}
+}
+
+func testSHA256(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
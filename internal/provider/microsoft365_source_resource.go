@@ -0,0 +1,92 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const microsoft365SourcePath = "/log-sources/microsoft365"
+
+func NewMicrosoft365SourceResource() resource.Resource {
+	return &saasSourceResource[microsoft365SourceModel, client.Microsoft365Source, *microsoft365SourceModel]{
+		typeName:   "_microsoft365_source",
+		name:       "Microsoft 365 Source",
+		path:       microsoft365SourcePath,
+		credential: "client_secret",
+		schema:     microsoft365SourceSchema,
+	}
+}
+
+// microsoft365SourceModel adds the Microsoft 365 fields to saasSourceModel.
+// The *Wo fields are only ever set in config; see secretValue.
+type microsoft365SourceModel struct {
+	saasSourceModel
+	TenantId              types.String `tfsdk:"tenant_id"`
+	ClientId              types.String `tfsdk:"client_id"`
+	ClientSecret          types.String `tfsdk:"client_secret"`
+	ClientSecretWo        types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWoVersion types.Int64  `tfsdk:"client_secret_wo_version"`
+}
+
+func microsoft365SourceSchema() schema.Schema {
+	attributes := saasSourceAttributes()
+	attributes["tenant_id"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The ID of the Microsoft Entra tenant to collect activity from",
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(azureGUIDRegex, "must be a tenant ID (a GUID)"),
+		},
+	}
+	attributes["client_id"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The application (client) ID of an app registration with the ActivityFeed.Read permission",
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(azureGUIDRegex, "must be an application (client) ID (a GUID)"),
+		},
+	}
+	attributes["client_secret"] = credentialAttribute("A client secret of the app registration.")
+	return schema.Schema{
+		MarkdownDescription: "Manages a Microsoft 365 log source that subscribes to the Office 365 Management Activity API.",
+		Attributes:          attributes,
+	}
+}
+
+func (m microsoft365SourceModel) toAPI(ctx context.Context, config tfsdk.Config, diagnostics *diag.Diagnostics) client.Microsoft365Source {
+	return client.Microsoft365Source{
+		SaasSourceCore: m.toCore(),
+		TenantId:       m.TenantId.ValueString(),
+		ClientId:       m.ClientId.ValueString(),
+		ClientSecret:   secretValue(ctx, config, "client_secret", m.ClientSecret, diagnostics),
+	}
+}
+
+func (m *microsoft365SourceModel) set(ctx context.Context, source client.Microsoft365Source, diagnostics *diag.Diagnostics) {
+	m.setCore(ctx, source.SaasSourceCore, diagnostics)
+	m.TenantId = types.StringValue(source.TenantId)
+	m.ClientId = types.StringValue(source.ClientId)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const oktaSourcePath = "/log-sources/okta"
+
+var (
+	oktaDomainRegex = regexp.MustCompile(`^[A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?(?:\.[A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?)+$`)
+)
+
+func NewOktaSourceResource() resource.Resource {
+	return &saasSourceResource[oktaSourceModel, client.OktaSource, *oktaSourceModel]{
+		typeName:   "_okta_source",
+		name:       "Okta Source",
+		path:       oktaSourcePath,
+		credential: "api_token",
+		schema:     oktaSourceSchema,
+	}
+}
+
+// oktaSourceModel adds the Okta fields to saasSourceModel. The *Wo fields
+// are only ever set in config; see secretValue.
+type oktaSourceModel struct {
+	saasSourceModel
+	OktaDomain          types.String `tfsdk:"okta_domain"`
+	ApiToken            types.String `tfsdk:"api_token"`
+	ApiTokenWo          types.String `tfsdk:"api_token_wo"`
+	ApiTokenWoVersion   types.Int64  `tfsdk:"api_token_wo_version"`
+	PullIntervalMinutes types.Int64  `tfsdk:"pull_interval_minutes"`
+}
+
+func oktaSourceSchema() schema.Schema {
+	attributes := saasSourceAttributes()
+	attributes["okta_domain"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The domain of the Okta org, without a scheme (e.g. example.okta.com)",
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(oktaDomainRegex, "must be a domain without a scheme or path, e.g. example.okta.com"),
+		},
+	}
+	attributes["api_token"] = credentialAttribute("An Okta API token created by a read-only administrator.")
+	attributes["pull_interval_minutes"] = pullIntervalAttribute()
+	return schema.Schema{
+		MarkdownDescription: "Manages an Okta log source that pulls the System Log of an Okta org.",
+		Attributes:          attributes,
+	}
+}
+
+func (m oktaSourceModel) toAPI(ctx context.Context, config tfsdk.Config, diagnostics *diag.Diagnostics) client.OktaSource {
+	return client.OktaSource{
+		SaasSourceCore:      m.toCore(),
+		Domain:              m.OktaDomain.ValueString(),
+		ApiToken:            secretValue(ctx, config, "api_token", m.ApiToken, diagnostics),
+		PullIntervalMinutes: m.PullIntervalMinutes.ValueInt64(),
+	}
+}
+
+func (m *oktaSourceModel) set(ctx context.Context, source client.OktaSource, diagnostics *diag.Diagnostics) {
+	m.setCore(ctx, source.SaasSourceCore, diagnostics)
+	m.OktaDomain = types.StringValue(source.Domain)
+	m.PullIntervalMinutes = types.Int64Value(source.PullIntervalMinutes)
+}
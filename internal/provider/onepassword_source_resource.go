@@ -0,0 +1,91 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const onePasswordSourcePath = "/log-sources/onepassword"
+
+// onePasswordEventsAPIHosts are the Events API hosts of the 1Password regions.
+var onePasswordEventsAPIHosts = []string{"events.1password.com", "events.ent.1password.com", "events.1password.ca", "events.1password.eu"}
+
+func NewOnePasswordSourceResource() resource.Resource {
+	return &saasSourceResource[onePasswordSourceModel, client.OnePasswordSource, *onePasswordSourceModel]{
+		typeName:   "_onepassword_source",
+		name:       "1Password Source",
+		path:       onePasswordSourcePath,
+		credential: "bearer_token",
+		schema:     onePasswordSourceSchema,
+	}
+}
+
+// onePasswordSourceModel adds the 1Password fields to saasSourceModel.
+// The *Wo fields are only ever set in config; see secretValue.
+type onePasswordSourceModel struct {
+	saasSourceModel
+	EventsApiHost        types.String `tfsdk:"events_api_host"`
+	BearerToken          types.String `tfsdk:"bearer_token"`
+	BearerTokenWo        types.String `tfsdk:"bearer_token_wo"`
+	BearerTokenWoVersion types.Int64  `tfsdk:"bearer_token_wo_version"`
+	PullIntervalMinutes  types.Int64  `tfsdk:"pull_interval_minutes"`
+}
+
+func onePasswordSourceSchema() schema.Schema {
+	attributes := saasSourceAttributes()
+	attributes["events_api_host"] = schema.StringAttribute{
+		Required:    true,
+		Description: fmt.Sprintf("The Events API host of the account's region. One of: %s", strings.Join(onePasswordEventsAPIHosts, ", ")),
+		Validators: []validator.String{
+			stringvalidator.OneOf(onePasswordEventsAPIHosts...),
+		},
+	}
+	attributes["bearer_token"] = credentialAttribute("A bearer token of an Events Reporting integration with access to sign-in attempts, item usage and audit events.")
+	attributes["pull_interval_minutes"] = pullIntervalAttribute()
+	return schema.Schema{
+		MarkdownDescription: "Manages a 1Password log source that pulls sign-in attempts, item usage and audit events from the 1Password Events API.",
+		Attributes:          attributes,
+	}
+}
+
+func (m onePasswordSourceModel) toAPI(ctx context.Context, config tfsdk.Config, diagnostics *diag.Diagnostics) client.OnePasswordSource {
+	return client.OnePasswordSource{
+		SaasSourceCore:      m.toCore(),
+		EventsApiHost:       m.EventsApiHost.ValueString(),
+		BearerToken:         secretValue(ctx, config, "bearer_token", m.BearerToken, diagnostics),
+		PullIntervalMinutes: m.PullIntervalMinutes.ValueInt64(),
+	}
+}
+
+func (m *onePasswordSourceModel) set(ctx context.Context, source client.OnePasswordSource, diagnostics *diag.Diagnostics) {
+	m.setCore(ctx, source.SaasSourceCore, diagnostics)
+	m.EventsApiHost = types.StringValue(source.EventsApiHost)
+	m.PullIntervalMinutes = types.Int64Value(source.PullIntervalMinutes)
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*policyResource)(nil)
+	_ resource.ResourceWithConfigure   = (*policyResource)(nil)
+	_ resource.ResourceWithImportState = (*policyResource)(nil)
+)
+
+func NewPolicyResource() resource.Resource {
+	return &policyResource{}
+}
+
+type policyResource struct {
+	rest *client.RESTClient
+}
+
+type policyModel struct {
+	detectionModel
+	ResourceTypes types.List `tfsdk:"resource_types"`
+}
+
+func (r *policyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+func (r *policyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := detectionAttributes("resource")
+	attributes["resource_types"] = requiredStringListAttribute("The cloud resource types the policy evaluates, e.g. `AWS.S3.Bucket`.")
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Panther policy: a Python detection run against every scanned cloud resource " +
+			"of its resource types.",
+		Attributes: attributes,
+	}
+}
+
+func (r *policyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data policyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := data.toAPI(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := client.RestDo[client.Policy](ctx, r.rest, http.MethodPost, policyPath, input)
+	if handleCreateError(resp, "Policy", err) {
+		return
+	}
+	tflog.Debug(ctx, "Created Policy", map[string]any{"id": policy.ID})
+
+	data.set(ctx, policy, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data policyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := client.RestDo[client.Policy](ctx, r.rest, http.MethodGet, policyPath+"/"+data.Id.ValueString(), nil)
+	if handleReadError(ctx, resp, "Policy", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Read Policy", map[string]any{"id": policy.ID})
+
+	data.set(ctx, policy, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data policyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := data.toAPI(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := client.RestDo[client.Policy](ctx, r.rest, http.MethodPut, policyPath+"/"+data.Id.ValueString(), input)
+	if handleUpdateError(ctx, resp, "Policy", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated Policy", map[string]any{"id": data.Id.ValueString()})
+
+	data.set(ctx, policy, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data policyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RestDelete(ctx, r.rest, policyPath+"/"+data.Id.ValueString())
+	if handleDeleteError(resp, "Policy", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Deleted Policy", map[string]any{"id": data.Id.ValueString()})
+}
+
+func (r *policyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m policyModel) toAPI(ctx context.Context, diagnostics *diag.Diagnostics) client.Policy {
+	return client.Policy{
+		DetectionCore: m.toCore(ctx, diagnostics),
+		ResourceTypes: listToStringSlice(ctx, m.ResourceTypes, diagnostics),
+	}
+}
+
+func (m *policyModel) set(ctx context.Context, policy client.Policy, diagnostics *diag.Diagnostics) {
+	m.setCore(ctx, policy.DetectionCore, diagnostics)
+	m.ResourceTypes = stringSliceToList(ctx, nonNilStrings(policy.ResourceTypes), diagnostics)
+}
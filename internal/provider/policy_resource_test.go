@@ -0,0 +1,66 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPolicyResource(t *testing.T) {
+	id := "Test.Policy." + strings.ReplaceAll(uuid.NewString(), "-", "")
+	config := func(severity, extra string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "panther_policy" "test" {
+  id             = %q
+  severity       = %q
+  resource_types = ["AWS.S3.Bucket"]
+  body           = "def policy(resource):\n    return True\n"
+  %s
+}
+`, id, severity, extra)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("LOW", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_policy.test", "id", id),
+					resource.TestCheckResourceAttr("panther_policy.test", "resource_types.0", "AWS.S3.Bucket"),
+					resource.TestCheckResourceAttr("panther_policy.test", "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "panther_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config("HIGH", `tags = ["updated"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_policy.test", "severity", "HIGH"),
+					resource.TestCheckResourceAttr("panther_policy.test", "tags.0", "updated"),
+				),
+			},
+		},
+	})
+}
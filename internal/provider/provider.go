@@ -18,19 +18,30 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
+
 	"terraform-provider-panther/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure PantherProvider satisfies various provider interfaces.
-var _ provider.Provider = &PantherProvider{}
+var (
+	_ provider.Provider                       = &PantherProvider{}
+	_ provider.ProviderWithEphemeralResources = &PantherProvider{}
+)
 
 // PantherProvider defines the provider implementation.
 type PantherProvider struct {
@@ -42,10 +53,19 @@ type PantherProvider struct {
 
 // PantherProviderModel describes the provider data model.
 type PantherProviderModel struct {
-	Url   types.String `tfsdk:"url"`
-	Token types.String `tfsdk:"token"`
+	Url                    types.String  `tfsdk:"url"`
+	Token                  types.String  `tfsdk:"token"`
+	MaxRetries             types.Int64   `tfsdk:"max_retries"`
+	RetryMinBackoffSeconds types.Int64   `tfsdk:"retry_min_backoff_seconds"`
+	RetryMaxBackoffSeconds types.Int64   `tfsdk:"retry_max_backoff_seconds"`
+	MaxRequestsPerSecond   types.Float64 `tfsdk:"max_requests_per_second"`
+	MaxConcurrentRequests  types.Int64   `tfsdk:"max_concurrent_requests"`
 }
 
+// defaultMaxConcurrentRequests matches Terraform's default -parallelism, so the
+// cap only bites when users raise parallelism past what the API comfortably takes.
+const defaultMaxConcurrentRequests = 10
+
 func (p *PantherProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "panther"
 	resp.Version = p.version
@@ -63,6 +83,37 @@ func (p *PantherProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of times a throttled (429) or transiently failing (502/503/504) "+
+					"API request is retried. Set to 0 to disable retries. Defaults to %d.", client.DefaultMaxRetries),
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"retry_min_backoff_seconds": schema.Int64Attribute{
+				Description: fmt.Sprintf("Base delay in seconds before the first retry; later retries back off "+
+					"exponentially with jitter. Defaults to %d.", int64(client.DefaultMinBackoff/time.Second)),
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"retry_max_backoff_seconds": schema.Int64Attribute{
+				Description: fmt.Sprintf("Upper bound in seconds for any single retry delay, including delays requested "+
+					"by the API's Retry-After header. Defaults to %d.", int64(client.DefaultMaxBackoff/time.Second)),
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				Description: "Client-side rate limit for Panther API requests, shared by every resource and data source " +
+					"in the run. Fractional values are allowed (e.g. 0.5 for one request every two seconds). " +
+					"Set to 0 or omit for no rate limit.",
+				Optional:   true,
+				Validators: []validator.Float64{float64validator.AtLeast(0)},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of Panther API requests in flight at once, shared by every "+
+					"resource and data source in the run. Set to 0 for no limit. Defaults to %d.", defaultMaxConcurrentRequests),
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
 		},
 	}
 }
@@ -118,12 +169,53 @@ func (p *PantherProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	retry := retryPolicy(data, &resp.Diagnostics)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	userAgent := client.BuildUserAgent(p.version, req.TerraformVersion)
-	resp.ResourceData = client.NewRESTClient(url, token, userAgent)
+	c := client.NewRESTClient(url, token, userAgent, client.WithLimits(clientLimits(data)))
+	c.Retry = retry
+	resp.ResourceData = c
+	resp.DataSourceData = c
+	resp.EphemeralResourceData = c
+}
+
+// clientLimits converts the provider's throttling settings to client.Limits.
+func clientLimits(data PantherProviderModel) client.Limits {
+	limits := client.Limits{MaxConcurrentRequests: defaultMaxConcurrentRequests}
+	if !data.MaxRequestsPerSecond.IsNull() && !data.MaxRequestsPerSecond.IsUnknown() {
+		limits.RequestsPerSecond = data.MaxRequestsPerSecond.ValueFloat64()
+	}
+	if !data.MaxConcurrentRequests.IsNull() && !data.MaxConcurrentRequests.IsUnknown() {
+		limits.MaxConcurrentRequests = int(data.MaxConcurrentRequests.ValueInt64())
+	}
+	return limits
+}
+
+// retryPolicy overlays the provider's retry settings on the client defaults.
+func retryPolicy(data PantherProviderModel, diagnostics *diag.Diagnostics) client.RetryPolicy {
+	policy := client.DefaultRetryPolicy()
+	if !data.MaxRetries.IsNull() && !data.MaxRetries.IsUnknown() {
+		policy.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+	if !data.RetryMinBackoffSeconds.IsNull() && !data.RetryMinBackoffSeconds.IsUnknown() {
+		policy.MinBackoff = time.Duration(data.RetryMinBackoffSeconds.ValueInt64()) * time.Second
+	}
+	if !data.RetryMaxBackoffSeconds.IsNull() && !data.RetryMaxBackoffSeconds.IsUnknown() {
+		policy.MaxBackoff = time.Duration(data.RetryMaxBackoffSeconds.ValueInt64()) * time.Second
+	}
+	if policy.MinBackoff > policy.MaxBackoff {
+		diagnostics.AddAttributeError(
+			path.Root("retry_min_backoff_seconds"),
+			"Invalid Retry Backoff",
+			fmt.Sprintf("retry_min_backoff_seconds (%s) must not exceed retry_max_backoff_seconds (%s).",
+				policy.MinBackoff, policy.MaxBackoff),
+		)
+	}
+	return policy
 }
 
 func (p *PantherProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -131,14 +223,58 @@ func (p *PantherProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewS3SourceResource,
 		NewHttpsourceResource,
 		NewPubsubsourceResource,
+		NewSqsSourceResource,
+		NewCloudWatchSourceResource,
+		NewEventBridgeSourceResource,
 		NewGcssourceResource,
+		NewAzureBlobSourceResource,
+		NewAzureEventHubSourceResource,
+		NewOktaSourceResource,
+		NewGitHubSourceResource,
+		NewSlackSourceResource,
+		NewGoogleWorkspaceSourceResource,
+		NewOnePasswordSourceResource,
+		NewMicrosoft365SourceResource,
 		NewLogSourceAlarmResource,
+		NewLogSourceAlarmsResource,
 		NewAwsCloudAccountResource,
+		NewSchemaResource,
+		NewRuleResource,
+		NewScheduledRuleResource,
+		NewPolicyResource,
+		NewGlobalHelperResource,
+		NewDataModelResource,
+		NewSavedQueryResource,
+		NewScheduledQueryResource,
+		NewSlackDestinationResource,
+		NewPagerDutyDestinationResource,
+		NewWebhookDestinationResource,
+		NewSNSDestinationResource,
+		NewJiraDestinationResource,
+		NewOpsgenieDestinationResource,
+		NewLookupTableResource,
+		NewRoleResource,
+		NewUserResource,
+		NewAPITokenResource,
 	}
 }
 
 func (p *PantherProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewS3SourceDataSource,
+		NewHttpsourceDataSource,
+		NewPubsubsourceDataSource,
+		NewGcssourceDataSource,
+		NewLogSourceAlarmDataSource,
+		NewLogSourcesDataSource,
+		NewLogTypesDataSource,
+	}
+}
+
+func (p *PantherProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAPITokenEphemeralResource,
+	}
 }
 
 func New(version string) func() provider.Provider {
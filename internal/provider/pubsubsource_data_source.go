@@ -0,0 +1,135 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"maps"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                     = (*pubsubsourceDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*pubsubsourceDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*pubsubsourceDataSource)(nil)
+)
+
+func NewPubsubsourceDataSource() datasource.DataSource {
+	return &pubsubsourceDataSource{}
+}
+
+type pubsubsourceDataSource struct {
+	rest *client.RESTClient
+}
+
+// pubsubsourceDataSourceModel omits credentials: the API never returns them.
+type pubsubsourceDataSourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	IntegrationLabel     types.String `tfsdk:"integration_label"`
+	SubscriptionId       types.String `tfsdk:"subscription_id"`
+	ProjectId            types.String `tfsdk:"project_id"`
+	CredentialsType      types.String `tfsdk:"credentials_type"`
+	LogTypes             types.List   `tfsdk:"log_types"`
+	LogStreamType        types.String `tfsdk:"log_stream_type"`
+	LogStreamTypeOptions types.Object `tfsdk:"log_stream_type_options"`
+	RegionalEndpoint     types.String `tfsdk:"regional_endpoint"`
+}
+
+func (d *pubsubsourceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pubsubsource"
+}
+
+func (d *pubsubsourceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"subscription_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The GCP Pub/Sub subscription ID.",
+		},
+		"project_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The GCP project ID.",
+		},
+		"credentials_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The type of credentials being used: service_account or wif (Workload Identity Federation).",
+		},
+		"log_types": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "The log types for parsing ingested data.",
+		},
+		"log_stream_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The log stream type.",
+		},
+		"log_stream_type_options": logStreamTypeOptionsDataSourceAttribute(),
+		"regional_endpoint": schema.StringAttribute{
+			Computed:    true,
+			Description: "The regional endpoint override. Empty when the global endpoint is used.",
+		},
+	}
+	maps.Copy(attributes, logSourceLookupAttributes("Pub/Sub log source", "integration_label"))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing GCP Pub/Sub Log Source in Panther by `id` or `integration_label`. " +
+			"Credentials are never returned by the API and are not exposed.",
+		Attributes: attributes,
+	}
+}
+
+func (d *pubsubsourceDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return logSourceLookupValidators("integration_label")
+}
+
+func (d *pubsubsourceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.rest = dataSourceRESTClient(req, resp)
+}
+
+func (d *pubsubsourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data pubsubsourceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pubsubSource, ok := lookupLogSource(ctx, d.rest, pubsubSourcePath, "Pub/Sub Source", "integration_label", data.Id, data.IntegrationLabel,
+		func(s client.PubSubSource) string { return s.IntegrationLabel }, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	tflog.Debug(ctx, "Read Pub/Sub Source data source", map[string]any{"id": pubsubSource.IntegrationId})
+
+	data.Id = types.StringValue(pubsubSource.IntegrationId)
+	data.IntegrationLabel = types.StringValue(pubsubSource.IntegrationLabel)
+	data.SubscriptionId = types.StringValue(pubsubSource.SubscriptionId)
+	data.ProjectId = types.StringValue(pubsubSource.ProjectId)
+	data.CredentialsType = types.StringValue(pubsubSource.CredentialsType)
+	data.LogTypes = stringSliceToList(ctx, pubsubSource.LogTypes, &resp.Diagnostics)
+	data.LogStreamType = types.StringValue(pubsubSource.LogStreamType)
+	data.RegionalEndpoint = types.StringValue(pubsubSource.RegionalEndpoint)
+	data.LogStreamTypeOptions = types.ObjectNull(logStreamTypeOptionAttrTypes)
+	if opts := pubsubSource.LogStreamTypeOptions; opts != nil {
+		data.LogStreamTypeOptions = logStreamTypeOptionsToObject(opts.JsonArrayEnvelopeField, opts.XmlRootElement)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
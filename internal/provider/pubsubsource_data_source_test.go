@@ -0,0 +1,66 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestPubSubSourceDataSource reuses the service-account subscription from
+// TestPubSubSourceResource_ServiceAccount and, like TestGcsSourceDataSource, is
+// deliberately not parallel so the two never hold the subscription at once.
+func TestPubSubSourceDataSource(t *testing.T) {
+	credentials, projectId, subscriptionId, ok := loadPubSubTestConfig(t,
+		"PANTHER_PUBSUB_SA_CREDENTIALS_FILE",
+		"PANTHER_PUBSUB_SA_PROJECT_ID",
+		"PANTHER_PUBSUB_SA_SUBSCRIPTION_ID",
+	)
+	if !ok {
+		t.Skip("Skipping: PANTHER_PUBSUB_SA_CREDENTIALS_FILE, PANTHER_PUBSUB_SA_PROJECT_ID, and PANTHER_PUBSUB_SA_SUBSCRIPTION_ID must be set")
+	}
+
+	integrationLabel := "tf-automated-test-pubsub-datasource"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig +
+					testPubSubSourceResourceConfig(integrationLabel, subscriptionId, projectId, credentials, "service_account") + `
+data "panther_pubsubsource" "by_id" {
+  id = panther_pubsubsource.test.id
+}
+
+data "panther_pubsubsource" "by_label" {
+  integration_label = panther_pubsubsource.test.integration_label
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.panther_pubsubsource.by_id", "integration_label", "panther_pubsubsource.test", "integration_label"),
+					resource.TestCheckResourceAttrPair("data.panther_pubsubsource.by_label", "id", "panther_pubsubsource.test", "id"),
+					resource.TestCheckResourceAttrPair("data.panther_pubsubsource.by_label", "log_types.#", "panther_pubsubsource.test", "log_types.#"),
+					resource.TestCheckResourceAttrPair("data.panther_pubsubsource.by_label", "log_stream_type", "panther_pubsubsource.test", "log_stream_type"),
+					resource.TestCheckResourceAttr("data.panther_pubsubsource.by_label", "subscription_id", subscriptionId),
+					resource.TestCheckResourceAttr("data.panther_pubsubsource.by_label", "project_id", projectId),
+					resource.TestCheckResourceAttr("data.panther_pubsubsource.by_label", "credentials_type", "service_account"),
+					resource.TestCheckNoResourceAttr("data.panther_pubsubsource.by_label", "credentials"),
+				),
+			},
+		},
+	})
+}
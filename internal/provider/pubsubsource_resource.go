@@ -37,9 +37,11 @@ import (
 const pubsubSourcePath = "/log-sources/pubsub"
 
 var (
-	_ resource.Resource                = (*pubsubsourceResource)(nil)
-	_ resource.ResourceWithConfigure   = (*pubsubsourceResource)(nil)
-	_ resource.ResourceWithImportState = (*pubsubsourceResource)(nil)
+	_ resource.Resource                     = (*pubsubsourceResource)(nil)
+	_ resource.ResourceWithConfigure        = (*pubsubsourceResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*pubsubsourceResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*pubsubsourceResource)(nil)
+	_ resource.ResourceWithImportState      = (*pubsubsourceResource)(nil)
 )
 
 func NewPubsubsourceResource() resource.Resource {
@@ -50,6 +52,14 @@ type pubsubsourceResource struct {
 	rest *client.RESTClient
 }
 
+// pubsubsourceModel is the generated model plus the write-only variants of its
+// secrets. The *Wo fields are only ever set in config; see secretValue.
+type pubsubsourceModel struct {
+	resource_pubsubsource.PubsubsourceModel
+	CredentialsWo        types.String `tfsdk:"credentials_wo"`
+	CredentialsWoVersion types.Int64  `tfsdk:"credentials_wo_version"`
+}
+
 func (r *pubsubsourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_pubsubsource"
 }
@@ -59,7 +69,7 @@ func (r *pubsubsourceResource) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema.MarkdownDescription = "Represents a Google Cloud Pub/Sub Log Source in Panther"
 	applySchemaOverrides(&resp.Schema, []SchemaOverride{
 		{Name: "id", PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
-		{Name: "credentials", Default: stringdefault.StaticString(""), Sensitive: true},
+		{Name: "credentials", Default: stringdefault.StaticString(""), Sensitive: true, WriteOnly: true},
 		{Name: "project_id", PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
 		{Name: "regional_endpoint", Default: stringdefault.StaticString("")},
 	})
@@ -82,12 +92,20 @@ func (r *pubsubsourceResource) Schema(ctx context.Context, req resource.SchemaRe
 	resp.Schema.Attributes["log_stream_type_options"] = logStreamTypeOptions
 }
 
+func (r *pubsubsourceResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{logStreamTypeOptionsValidator{}}
+}
+
+func (r *pubsubsourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateLogTypes(ctx, r.rest, req, resp, logTypesExpression)
+}
+
 func (r *pubsubsourceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	r.rest = restClient(req, resp)
 }
 
 func (r *pubsubsourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data resource_pubsubsource.PubsubsourceModel
+	var data pubsubsourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -97,7 +115,7 @@ func (r *pubsubsourceResource) Create(ctx context.Context, req resource.CreateRe
 		IntegrationLabel:     data.IntegrationLabel.ValueString(),
 		SubscriptionId:       data.SubscriptionId.ValueString(),
 		ProjectId:            data.ProjectId.ValueString(),
-		Credentials:          data.Credentials.ValueString(),
+		Credentials:          secretValue(ctx, req.Config, "credentials", data.Credentials, &resp.Diagnostics),
 		CredentialsType:      data.CredentialsType.ValueString(),
 		LogTypes:             listToStringSlice(ctx, data.LogTypes, &resp.Diagnostics),
 		LogStreamType:        data.LogStreamType.ValueString(),
@@ -125,7 +143,7 @@ func (r *pubsubsourceResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *pubsubsourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data resource_pubsubsource.PubsubsourceModel
+	var data pubsubsourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -169,7 +187,7 @@ func (r *pubsubsourceResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *pubsubsourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data resource_pubsubsource.PubsubsourceModel
+	var data pubsubsourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -179,7 +197,7 @@ func (r *pubsubsourceResource) Update(ctx context.Context, req resource.UpdateRe
 		IntegrationLabel:     data.IntegrationLabel.ValueString(),
 		SubscriptionId:       data.SubscriptionId.ValueString(),
 		ProjectId:            data.ProjectId.ValueString(),
-		Credentials:          data.Credentials.ValueString(),
+		Credentials:          secretValue(ctx, req.Config, "credentials", data.Credentials, &resp.Diagnostics),
 		CredentialsType:      data.CredentialsType.ValueString(),
 		LogTypes:             listToStringSlice(ctx, data.LogTypes, &resp.Diagnostics),
 		LogStreamType:        data.LogStreamType.ValueString(),
@@ -200,7 +218,7 @@ func (r *pubsubsourceResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *pubsubsourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_pubsubsource.PubsubsourceModel
+	var data pubsubsourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
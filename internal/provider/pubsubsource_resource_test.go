@@ -24,6 +24,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 // Acceptance tests for the panther_pubsubsource resource.
@@ -162,6 +163,38 @@ resource "panther_pubsubsource" "test" {
 			},
 		})
 	})
+
+	t.Run("WriteOnlyCredentials", func(t *testing.T) {
+		integrationLabel := "tf-test-pubsub-sa-write-only"
+
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + fmt.Sprintf(`
+resource "panther_pubsubsource" "test" {
+  integration_label      = "%s"
+  subscription_id        = "%s"
+  credentials_wo         = %q
+  credentials_wo_version = 1
+  credentials_type       = "service_account"
+  log_types              = ["GCP.AuditLog"]
+  log_stream_type        = "Auto"
+}
+`, integrationLabel, subscriptionId, credentials),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("panther_pubsubsource.test", "credentials", ""),
+						resource.TestCheckNoResourceAttr("panther_pubsubsource.test", "credentials_wo"),
+						resource.TestCheckResourceAttr("panther_pubsubsource.test", "credentials_wo_version", "1"),
+						resource.TestCheckResourceAttr("panther_pubsubsource.test", "credentials_type", "service_account"),
+					),
+				},
+			},
+		})
+	})
 }
 
 // TestPubSubSourceResource_WIF tests the full CRUD lifecycle using Workload Identity Federation.
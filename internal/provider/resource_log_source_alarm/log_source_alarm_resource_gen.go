@@ -12,10 +12,32 @@ import (
 func LogSourceAlarmResourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"count_threshold": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The number of events failing, for SOURCE_CLASSIFICATION_FAILURES and SOURCE_LOG_PROCESSING_ERRORS alarms",
+				MarkdownDescription: "The number of events failing, for SOURCE_CLASSIFICATION_FAILURES and SOURCE_LOG_PROCESSING_ERRORS alarms",
+			},
+			"destination_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "The destinations the alarm's alerts are sent to",
+				MarkdownDescription: "The destinations the alarm's alerts are sent to",
+			},
 			"minutes_threshold": schema.Int64Attribute{
-				Required:            true,
-				Description:         "The no-data evaluation period in minutes. Minimum 15, maximum 43200 (30 days).",
-				MarkdownDescription: "The no-data evaluation period in minutes. Minimum 15, maximum 43200 (30 days).",
+				Optional:            true,
+				Description:         "The no-data evaluation period in minutes, for SOURCE_NO_DATA alarms. Minimum 15, maximum 43200 (30 days).",
+				MarkdownDescription: "The no-data evaluation period in minutes, for SOURCE_NO_DATA alarms. Minimum 15, maximum 43200 (30 days).",
+			},
+			"muted": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Whether the alarm's alerts are suppressed",
+				MarkdownDescription: "Whether the alarm's alerts are suppressed",
+			},
+			"percentage_threshold": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The percentage of events failing, for SOURCE_CLASSIFICATION_FAILURES and SOURCE_LOG_PROCESSING_ERRORS alarms",
+				MarkdownDescription: "The percentage of events failing, for SOURCE_CLASSIFICATION_FAILURES and SOURCE_LOG_PROCESSING_ERRORS alarms",
 			},
 			"source_id": schema.StringAttribute{
 				Optional:            true,
@@ -33,7 +55,11 @@ func LogSourceAlarmResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type LogSourceAlarmModel struct {
-	MinutesThreshold types.Int64  `tfsdk:"minutes_threshold"`
-	SourceId         types.String `tfsdk:"source_id"`
-	Type             types.String `tfsdk:"type"`
+	CountThreshold      types.Int64  `tfsdk:"count_threshold"`
+	DestinationIds      types.List   `tfsdk:"destination_ids"`
+	MinutesThreshold    types.Int64  `tfsdk:"minutes_threshold"`
+	Muted               types.Bool   `tfsdk:"muted"`
+	PercentageThreshold types.Int64  `tfsdk:"percentage_threshold"`
+	SourceId            types.String `tfsdk:"source_id"`
+	Type                types.String `tfsdk:"type"`
 }
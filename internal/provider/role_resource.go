@@ -0,0 +1,228 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var logTypeAccessKinds = []string{"ALLOW_ALL", "ALLOW", "DENY"}
+
+var (
+	_ resource.Resource                   = (*roleResource)(nil)
+	_ resource.ResourceWithConfigure      = (*roleResource)(nil)
+	_ resource.ResourceWithImportState    = (*roleResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*roleResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*roleResource)(nil)
+)
+
+func NewRoleResource() resource.Resource {
+	return &roleResource{}
+}
+
+type roleResource struct {
+	rest *client.RESTClient
+}
+
+type roleModel struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Permissions       types.List   `tfsdk:"permissions"`
+	LogTypeAccessKind types.String `tfsdk:"log_type_access_kind"`
+	LogTypeAccess     types.List   `tfsdk:"log_type_access"`
+}
+
+func (r *roleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Panther role: the permissions and log type access of the users assigned to it " +
+			"(`panther_user`).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The role ID.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The role name, unique in the instance.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"permissions": permissionsAttribute("What members of the role can do."),
+			"log_type_access_kind": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("ALLOW_ALL"),
+				MarkdownDescription: "How `log_type_access` restricts the log data members can query: `ALLOW_ALL` " +
+					"(the default) grants every log type, `ALLOW` only the listed ones, `DENY` all but the listed ones.",
+				Validators: []validator.String{stringvalidator.OneOf(logTypeAccessKinds...)},
+			},
+			"log_type_access": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				MarkdownDescription: "The log types `log_type_access_kind` allows or denies. Must be empty for " +
+					"`ALLOW_ALL` and non-empty otherwise. Checked against the instance's schemas at plan time.",
+			},
+		},
+	}
+}
+
+func (r *roleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+// ValidateConfig checks log_type_access against log_type_access_kind.
+func (r *roleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data roleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.LogTypeAccessKind.IsUnknown() || data.LogTypeAccess.IsUnknown() {
+		return
+	}
+	kind := data.LogTypeAccessKind.ValueString()
+	if data.LogTypeAccessKind.IsNull() {
+		kind = "ALLOW_ALL"
+	}
+	listed := !data.LogTypeAccess.IsNull() && len(data.LogTypeAccess.Elements()) > 0
+	switch {
+	case kind == "ALLOW_ALL" && listed:
+		resp.Diagnostics.AddAttributeError(path.Root("log_type_access"), "Invalid Log Type Access",
+			`log_type_access must be empty when log_type_access_kind is "ALLOW_ALL". Set log_type_access_kind to "ALLOW" or "DENY" to restrict access.`)
+	case kind != "ALLOW_ALL" && !listed:
+		resp.Diagnostics.AddAttributeError(path.Root("log_type_access"), "Invalid Log Type Access",
+			"log_type_access must list at least one log type when log_type_access_kind is \""+kind+"\".")
+	}
+}
+
+func (r *roleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateLogTypes(ctx, r.rest, req, resp, path.MatchRoot("log_type_access"))
+}
+
+func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data roleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := data.toAPI(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := client.RestDo[client.Role](ctx, r.rest, http.MethodPost, rolePath, input)
+	if handleCreateError(resp, "Role", err) {
+		return
+	}
+	tflog.Debug(ctx, "Created Role", map[string]any{"id": role.ID})
+
+	data.set(ctx, role, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data roleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := client.RestDo[client.Role](ctx, r.rest, http.MethodGet, rolePath+"/"+data.Id.ValueString(), nil)
+	if handleReadError(ctx, resp, "Role", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Read Role", map[string]any{"id": role.ID})
+
+	data.set(ctx, role, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data roleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := data.toAPI(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := client.RestDo[client.Role](ctx, r.rest, http.MethodPut, rolePath+"/"+data.Id.ValueString(), input)
+	if handleUpdateError(ctx, resp, "Role", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated Role", map[string]any{"id": data.Id.ValueString()})
+
+	data.set(ctx, role, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data roleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RestDelete(ctx, r.rest, rolePath+"/"+data.Id.ValueString())
+	if handleDeleteError(resp, "Role", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Deleted Role", map[string]any{"id": data.Id.ValueString()})
+}
+
+func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m roleModel) toAPI(ctx context.Context, diagnostics *diag.Diagnostics) client.RoleInput {
+	return client.RoleInput{
+		Name:              m.Name.ValueString(),
+		Permissions:       nonNilStrings(listToStringSlice(ctx, m.Permissions, diagnostics)),
+		LogTypeAccessKind: m.LogTypeAccessKind.ValueString(),
+		LogTypeAccess:     nonNilStrings(listToStringSlice(ctx, m.LogTypeAccess, diagnostics)),
+	}
+}
+
+func (m *roleModel) set(ctx context.Context, role client.Role, diagnostics *diag.Diagnostics) {
+	m.Id = types.StringValue(role.ID)
+	m.Name = types.StringValue(role.Name)
+	m.Permissions = stringSliceToList(ctx, nonNilStrings(role.Permissions), diagnostics)
+	m.LogTypeAccessKind = types.StringValue(role.LogTypeAccessKind)
+	m.LogTypeAccess = stringSliceToList(ctx, nonNilStrings(role.LogTypeAccess), diagnostics)
+}
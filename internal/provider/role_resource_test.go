@@ -0,0 +1,119 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestRoleResource covers create with the default unrestricted log type access, import,
+// and an update restricting it.
+func TestRoleResource(t *testing.T) {
+	name := "test-role-" + uuid.NewString()
+	config := func(permissions, access string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "panther_role" "test" {
+  name        = %q
+  permissions = %s
+  %s
+}
+`, name, permissions, access)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`["AlertRead", "RuleRead"]`, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("panther_role.test", "id"),
+					resource.TestCheckResourceAttr("panther_role.test", "permissions.#", "2"),
+					resource.TestCheckResourceAttr("panther_role.test", "log_type_access_kind", "ALLOW_ALL"),
+					resource.TestCheckResourceAttr("panther_role.test", "log_type_access.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "panther_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(`["AlertRead", "AlertModify", "DataAnalyticsRead"]`, `
+  log_type_access_kind = "ALLOW"
+  log_type_access      = ["AWS.CloudTrail", "AWS.VPCFlow"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_role.test", "permissions.1", "AlertModify"),
+					resource.TestCheckResourceAttr("panther_role.test", "log_type_access_kind", "ALLOW"),
+					resource.TestCheckResourceAttr("panther_role.test", "log_type_access.1", "AWS.VPCFlow"),
+				),
+			},
+		},
+	})
+}
+
+// TestRoleResource_PlanTimeValidation covers errors raised before any API call.
+func TestRoleResource_PlanTimeValidation(t *testing.T) {
+	cases := map[string]struct {
+		permissions string
+		access      string
+		err         string
+	}{
+		"unknown_permission": {
+			permissions: `["RuleEdit"]`,
+			err:         `(?s)permissions\[0\].*value must be one of`,
+		},
+		"allow_all_with_list": {
+			permissions: `["RuleRead"]`,
+			access:      `log_type_access = ["AWS.CloudTrail"]`,
+			err:         `(?s)Invalid Log Type Access.*must be empty`,
+		},
+		"allow_without_list": {
+			permissions: `["RuleRead"]`,
+			access:      `log_type_access_kind = "DENY"`,
+			err:         `(?s)Invalid Log Type Access.*at least one log type`,
+		},
+		"unknown_log_type": {
+			permissions: `["RuleRead"]`,
+			access:      "log_type_access_kind = \"ALLOW\"\n  log_type_access = [\"AWS.Cloudtrail\"]",
+			err:         `(?s)Unknown Log Type.*Did you\s+mean\s+"AWS.CloudTrail"`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_role" "test" {
+  name        = "plan-time-validation"
+  permissions = %s
+  %s
+}
+`, tc.permissions, tc.access),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.err),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"maps"
+	"net/http"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*ruleResource)(nil)
+	_ resource.ResourceWithConfigure   = (*ruleResource)(nil)
+	_ resource.ResourceWithImportState = (*ruleResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*ruleResource)(nil)
+)
+
+func NewRuleResource() resource.Resource {
+	return &ruleResource{}
+}
+
+type ruleResource struct {
+	rest *client.RESTClient
+}
+
+type ruleModel struct {
+	detectionModel
+	LogTypes           types.List  `tfsdk:"log_types"`
+	DedupPeriodMinutes types.Int64 `tfsdk:"dedup_period_minutes"`
+	Threshold          types.Int64 `tfsdk:"threshold"`
+}
+
+func (r *ruleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rule"
+}
+
+func (r *ruleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := detectionAttributes("event")
+	maps.Copy(attributes, alertGroupingAttributes())
+	attributes["log_types"] = requiredStringListAttribute("The log types the rule runs on. Checked against the instance's " +
+		"schemas at plan time.")
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Panther rule: a Python detection run against every event of its log types.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *ruleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+func (r *ruleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateLogTypes(ctx, r.rest, req, resp, logTypesExpression)
+}
+
+func (r *ruleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ruleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := data.toAPI(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := client.RestDo[client.Rule](ctx, r.rest, http.MethodPost, rulePath, input)
+	if handleCreateError(resp, "Rule", err) {
+		return
+	}
+	tflog.Debug(ctx, "Created Rule", map[string]any{"id": rule.ID})
+
+	data.set(ctx, rule, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ruleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ruleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := client.RestDo[client.Rule](ctx, r.rest, http.MethodGet, rulePath+"/"+data.Id.ValueString(), nil)
+	if handleReadError(ctx, resp, "Rule", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Read Rule", map[string]any{"id": rule.ID})
+
+	data.set(ctx, rule, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ruleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ruleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := data.toAPI(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := client.RestDo[client.Rule](ctx, r.rest, http.MethodPut, rulePath+"/"+data.Id.ValueString(), input)
+	if handleUpdateError(ctx, resp, "Rule", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated Rule", map[string]any{"id": data.Id.ValueString()})
+
+	data.set(ctx, rule, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ruleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ruleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RestDelete(ctx, r.rest, rulePath+"/"+data.Id.ValueString())
+	if handleDeleteError(resp, "Rule", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Deleted Rule", map[string]any{"id": data.Id.ValueString()})
+}
+
+func (r *ruleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m ruleModel) toAPI(ctx context.Context, diagnostics *diag.Diagnostics) client.Rule {
+	return client.Rule{
+		DetectionCore:      m.toCore(ctx, diagnostics),
+		LogTypes:           listToStringSlice(ctx, m.LogTypes, diagnostics),
+		DedupPeriodMinutes: m.DedupPeriodMinutes.ValueInt64(),
+		Threshold:          m.Threshold.ValueInt64(),
+	}
+}
+
+func (m *ruleModel) set(ctx context.Context, rule client.Rule, diagnostics *diag.Diagnostics) {
+	m.setCore(ctx, rule.DetectionCore, diagnostics)
+	m.LogTypes = stringSliceToList(ctx, nonNilStrings(rule.LogTypes), diagnostics)
+	m.DedupPeriodMinutes = types.Int64Value(rule.DedupPeriodMinutes)
+	m.Threshold = types.Int64Value(rule.Threshold)
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"terraform-provider-panther/internal/client"
+)
+
+// TestRuleResource covers create with defaults, import, and an update that sets every
+// optional attribute, including unit tests and report mappings.
+func TestRuleResource(t *testing.T) {
+	id := "Test.Rule." + strings.ReplaceAll(uuid.NewString(), "-", "")
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "panther_rule" "test" {
+  id        = %q
+  severity  = "LOW"
+  log_types = ["AWS.CloudTrail"]
+  body      = <<-EOT
+    def rule(event):
+        return event.get("eventName") == "ConsoleLogin"
+  EOT
+}
+`, id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_rule.test", "id", id),
+					resource.TestCheckResourceAttr("panther_rule.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("panther_rule.test", "dedup_period_minutes", "60"),
+					resource.TestCheckResourceAttr("panther_rule.test", "threshold", "1"),
+					resource.TestCheckResourceAttr("panther_rule.test", "tags.#", "0"),
+					resource.TestCheckResourceAttr("panther_rule.test", "reports.%", "0"),
+					resource.TestCheckResourceAttr("panther_rule.test", "tests.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "panther_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "panther_rule" "test" {
+  id                   = %q
+  display_name         = "Console login"
+  description          = "A user logged in to the AWS console."
+  severity             = "MEDIUM"
+  enabled              = false
+  log_types            = ["AWS.CloudTrail"]
+  dedup_period_minutes = 180
+  threshold            = 5
+  tags                 = ["aws", "identity"]
+  runbook              = "Check the source IP against the VPN ranges."
+  reference            = "https://docs.aws.amazon.com/"
+  reports = {
+    "MITRE ATT&CK" = ["TA0001:T1078"]
+  }
+  body = <<-EOT
+    def rule(event):
+        return event.get("eventName") == "ConsoleLogin"
+  EOT
+  tests = [
+    {
+      name            = "console login"
+      expected_result = true
+      resource        = jsonencode({ eventName = "ConsoleLogin" })
+    },
+    {
+      name            = "other event"
+      expected_result = false
+      resource        = jsonencode({ eventName = "GetObject" })
+    },
+  ]
+}
+`, id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_rule.test", "display_name", "Console login"),
+					resource.TestCheckResourceAttr("panther_rule.test", "severity", "MEDIUM"),
+					resource.TestCheckResourceAttr("panther_rule.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("panther_rule.test", "dedup_period_minutes", "180"),
+					resource.TestCheckResourceAttr("panther_rule.test", "threshold", "5"),
+					resource.TestCheckResourceAttr("panther_rule.test", "tags.1", "identity"),
+					resource.TestCheckResourceAttr("panther_rule.test", "reports.MITRE ATT&CK.0", "TA0001:T1078"),
+					resource.TestCheckResourceAttr("panther_rule.test", "tests.#", "2"),
+					resource.TestCheckResourceAttr("panther_rule.test", "tests.1.expected_result", "false"),
+				),
+			},
+		},
+	})
+}
+
+// TestRuleResource_ReformattedTestResource configures a unit test whose JSON the API
+// returns reformatted (indented, keys sorted). The resource must be compared as JSON, or
+// the apply fails with an inconsistent result and every later plan shows a diff.
+func TestRuleResource_ReformattedTestResource(t *testing.T) {
+	id := "Test.Rule." + strings.ReplaceAll(uuid.NewString(), "-", "")
+	const testResource = `{"userIdentity": {"type": "Root"},   "eventName": "ConsoleLogin"}`
+	config := providerConfig + fmt.Sprintf(`
+resource "panther_rule" "test" {
+  id        = %q
+  severity  = "LOW"
+  log_types = ["AWS.CloudTrail"]
+  body      = <<-EOT
+    def rule(event):
+        return event.get("eventName") == "ConsoleLogin"
+  EOT
+  tests = [{
+    name            = "root login"
+    expected_result = true
+    resource        = %q
+  }]
+}
+`, id, testResource)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_rule.test", "tests.0.resource", testResource),
+					func(*terraform.State) error {
+						c := client.NewRESTClient(os.Getenv("PANTHER_API_URL"), os.Getenv("PANTHER_API_TOKEN"), testUserAgent)
+						rule, err := client.RestDo[client.Rule](context.Background(), c, http.MethodGet, rulePath+"/"+id, nil)
+						if err != nil {
+							return err
+						}
+						if len(rule.Tests) != 1 {
+							return fmt.Errorf("expected 1 test, got %d", len(rule.Tests))
+						}
+						if rule.Tests[0].Resource == testResource {
+							t.Log("The API kept the test resource's formatting; the reformatting case isn't exercised")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// TestRuleResource_PlanTimeValidation covers the attribute checks that fail before any
+// API call.
+func TestRuleResource_PlanTimeValidation(t *testing.T) {
+	cases := []struct {
+		name        string
+		attributes  string
+		expectError string
+	}{
+		{"bad_severity", `severity = "SEVERE"
+  log_types = ["AWS.CloudTrail"]`, `Invalid Attribute Value Match`},
+		{"no_log_types", `severity = "LOW"
+  log_types = []`, `log_types list must contain at least 1`},
+		{"unknown_log_type", `severity = "LOW"
+  log_types = ["AWS.Cloudtrail"]`, `Did you\s+mean\s+"AWS.CloudTrail"`},
+		{"test_resource_not_json", `severity = "LOW"
+  log_types = ["AWS.CloudTrail"]
+  tests     = [{ name = "t", expected_result = true, resource = "{not json" }]`, `Invalid JSON`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_rule" "test" {
+  id   = "Test.Rule.Validation"
+  body = "def rule(event):\n    return True\n"
+  %s
+}
+`, tc.attributes),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.expectError),
+					},
+				},
+			})
+		})
+	}
+}
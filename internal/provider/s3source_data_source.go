@@ -0,0 +1,151 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"maps"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                     = (*S3SourceDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*S3SourceDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*S3SourceDataSource)(nil)
+)
+
+func NewS3SourceDataSource() datasource.DataSource {
+	return &S3SourceDataSource{}
+}
+
+// S3SourceDataSource keeps the S3 resource's attribute names (`name`, `bucket_name`, ...)
+// so a data source and a resource for the same source read the same.
+type S3SourceDataSource struct {
+	rest *client.RESTClient
+}
+
+type S3SourceDataSourceModel struct {
+	AWSAccountID                             types.String          `tfsdk:"aws_account_id"`
+	KMSKeyARN                                types.String          `tfsdk:"kms_key_arn"`
+	Name                                     types.String          `tfsdk:"name"`
+	LogProcessingRoleARN                     types.String          `tfsdk:"log_processing_role_arn"`
+	LogStreamType                            types.String          `tfsdk:"log_stream_type"`
+	LogStreamTypeOptions                     types.Object          `tfsdk:"log_stream_type_options"`
+	PantherManagedBucketNotificationsEnabled types.Bool            `tfsdk:"panther_managed_bucket_notifications_enabled"`
+	BucketName                               types.String          `tfsdk:"bucket_name"`
+	PrefixLogTypes                           []PrefixLogTypesModel `tfsdk:"prefix_log_types"`
+	Id                                       types.String          `tfsdk:"id"`
+}
+
+func (d *S3SourceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_source"
+}
+
+func (d *S3SourceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"aws_account_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the AWS Account where the S3 Bucket is located.",
+		},
+		"kms_key_arn": schema.StringAttribute{
+			Computed:    true,
+			Description: "The KMS key ARN used to access the S3 Bucket.",
+		},
+		"log_processing_role_arn": schema.StringAttribute{
+			Computed:    true,
+			Description: "The AWS Role used to access the S3 Bucket.",
+		},
+		"log_stream_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The format of the log files being ingested.",
+		},
+		"log_stream_type_options": schema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "Options specific to the log stream type. Null when none are set.",
+			Attributes: map[string]schema.Attribute{
+				"json_array_envelope_field": schema.StringAttribute{
+					Computed:    true,
+					Description: "Path to the JSON array field to extract records from. Only applicable when log_stream_type is JsonArray.",
+				},
+				"retain_envelope_fields": schema.BoolAttribute{
+					Computed:    true,
+					Description: "Whether CloudWatch Logs envelope metadata is preserved in a p_header column. Only applicable when log_stream_type is CloudWatchLogs.",
+				},
+				"xml_root_element": schema.StringAttribute{
+					Computed:    true,
+					Description: "Root element wrapping XML events. Only applicable when log_stream_type is XML.",
+				},
+			},
+		},
+		"panther_managed_bucket_notifications_enabled": schema.BoolAttribute{
+			Computed:    true,
+			Description: "True if bucket notifications are being managed by Panther.",
+		},
+		"bucket_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the S3 Bucket where logs are ingested from.",
+		},
+		"prefix_log_types": prefixLogTypesDataSourceAttribute("S3 Prefix the log types are mapped to."),
+	}
+	maps.Copy(attributes, logSourceLookupAttributes("S3 log source", "name"))
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing S3 Log Source in Panther by `id` or `name`.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *S3SourceDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return logSourceLookupValidators("name")
+}
+
+func (d *S3SourceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.rest = dataSourceRESTClient(req, resp)
+}
+
+func (d *S3SourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data S3SourceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s3Source, ok := lookupLogSource(ctx, d.rest, s3SourcePath, "S3 Source", "name", data.Id, data.Name,
+		func(s client.S3Source) string { return s.IntegrationLabel }, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	tflog.Debug(ctx, "Read S3 Source data source", map[string]any{"id": s3Source.IntegrationId})
+
+	data.Id = types.StringValue(s3Source.IntegrationId)
+	data.AWSAccountID = types.StringValue(s3Source.AwsAccountId)
+	data.KMSKeyARN = types.StringValue(s3Source.KmsKey)
+	data.Name = types.StringValue(s3Source.IntegrationLabel)
+	data.LogProcessingRoleARN = types.StringValue(s3Source.LogProcessingRole)
+	data.LogStreamType = types.StringValue(s3Source.LogStreamType)
+	data.LogStreamTypeOptions = s3LogStreamTypeOptionsToModel(s3Source.LogStreamTypeOptions)
+	data.PantherManagedBucketNotificationsEnabled = types.BoolValue(s3Source.ManagedBucketNotifications)
+	data.BucketName = types.StringValue(s3Source.S3Bucket)
+	data.PrefixLogTypes = prefixLogTypesToModel(s3Source.S3PrefixLogTypes)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestS3SourceDataSource(t *testing.T) {
+	cfg, ok := loadS3TestConfig(t)
+	if !ok {
+		t.Skip("Skipping: PANTHER_S3_AWS_ACCOUNT_ID, PANTHER_S3_BUCKET_NAME, and PANTHER_S3_LOG_PROCESSING_ROLE_ARN must be set")
+	}
+
+	name := strings.ReplaceAll(uuid.NewString(), "-", "")
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkS3SourceDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testS3SourceConfig_Basic(cfg, name) + `
+data "panther_s3_source" "by_id" {
+  id = panther_s3_source.test.id
+}
+
+data "panther_s3_source" "by_name" {
+  name = panther_s3_source.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.panther_s3_source.by_id", "name", "panther_s3_source.test", "name"),
+					resource.TestCheckResourceAttrPair("data.panther_s3_source.by_name", "id", "panther_s3_source.test", "id"),
+					resource.TestCheckResourceAttr("data.panther_s3_source.by_name", "aws_account_id", cfg.awsAccountID),
+					resource.TestCheckResourceAttr("data.panther_s3_source.by_name", "bucket_name", cfg.bucketName),
+					resource.TestCheckResourceAttr("data.panther_s3_source.by_name", "log_processing_role_arn", cfg.logProcessingRoleARN),
+					resource.TestCheckResourceAttr("data.panther_s3_source.by_name", "log_stream_type", "Lines"),
+					resource.TestCheckResourceAttr("data.panther_s3_source.by_name", "panther_managed_bucket_notifications_enabled", "true"),
+					resource.TestCheckResourceAttr("data.panther_s3_source.by_name", "prefix_log_types.0.prefix", "test/prefix"),
+					resource.TestCheckResourceAttr("data.panther_s3_source.by_name", "prefix_log_types.0.excluded_prefixes.0", "test/prefix/excluded"),
+					resource.TestCheckResourceAttr("data.panther_s3_source.by_name", "prefix_log_types.0.log_types.0", "AWS.CloudTrail"),
+					resource.TestCheckNoResourceAttr("data.panther_s3_source.by_name", "log_stream_type_options.xml_root_element"),
+				),
+			},
+		},
+	})
+}
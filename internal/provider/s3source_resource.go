@@ -48,9 +48,11 @@ var s3LogStreamTypeOptionAttrTypes = map[string]attr.Type{
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = (*S3SourceResource)(nil)
-	_ resource.ResourceWithImportState = (*S3SourceResource)(nil)
-	_ resource.ResourceWithConfigure   = (*S3SourceResource)(nil)
+	_ resource.Resource                     = (*S3SourceResource)(nil)
+	_ resource.ResourceWithImportState      = (*S3SourceResource)(nil)
+	_ resource.ResourceWithConfigure        = (*S3SourceResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*S3SourceResource)(nil)
+	_ resource.ResourceWithModifyPlan       = (*S3SourceResource)(nil)
 )
 
 func NewS3SourceResource() resource.Resource {
@@ -202,6 +204,14 @@ To manage the notification-related infrastructure through terraform, refer to [t
 	}
 }
 
+func (r *S3SourceResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{logStreamTypeOptionsValidator{}}
+}
+
+func (r *S3SourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateLogTypes(ctx, r.rest, req, resp, prefixLogTypesExpression)
+}
+
 func (r *S3SourceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	r.rest = restClient(req, resp)
 }
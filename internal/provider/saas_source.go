@@ -0,0 +1,258 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// SaaS log pullers (panther_okta_source, panther_github_source, panther_slack_source,
+// panther_google_workspace_source, panther_onepassword_source and
+// panther_microsoft365_source) are hand-written resources over endpoints that share a
+// core. saasSourceResource implements them all; each integration's file holds its schema,
+// model and API conversion, and this file the shared attributes.
+// Panther checks the credentials with the third party on every save, and
+// addCredentialDiagnostic explains a refusal per integration.
+
+const defaultPullIntervalMinutes = 5
+
+var (
+	_ resource.Resource                     = (*saasSourceResource[oktaSourceModel, client.OktaSource, *oktaSourceModel])(nil)
+	_ resource.ResourceWithConfigure        = (*saasSourceResource[oktaSourceModel, client.OktaSource, *oktaSourceModel])(nil)
+	_ resource.ResourceWithImportState      = (*saasSourceResource[oktaSourceModel, client.OktaSource, *oktaSourceModel])(nil)
+	_ resource.ResourceWithConfigValidators = (*saasSourceResource[oktaSourceModel, client.OktaSource, *oktaSourceModel])(nil)
+)
+
+// saasSourceModelPtr is what saasSourceResource needs from a puller's model M, a
+// struct embedding saasSourceModel, converted to and from the API type S.
+type saasSourceModelPtr[M, S any] interface {
+	*M
+	core() *saasSourceModel
+	toAPI(ctx context.Context, config tfsdk.Config, diagnostics *diag.Diagnostics) S
+	// set copies an API response into the model. The credential is write-only in the
+	// API and keeps its configured value.
+	set(ctx context.Context, source S, diagnostics *diag.Diagnostics)
+}
+
+// saasSourceResource manages one SaaS puller: name is used in diagnostics and logs
+// (e.g. "Okta Source"), path is the collection endpoint and credential the attribute
+// that gets a write-only variant.
+type saasSourceResource[M, S any, P saasSourceModelPtr[M, S]] struct {
+	rest       *client.RESTClient
+	typeName   string
+	name       string
+	path       string
+	credential string
+	schema     func() schema.Schema
+}
+
+func (r *saasSourceResource[M, S, P]) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeName
+}
+
+func (r *saasSourceResource[M, S, P]) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = r.schema()
+	applySchemaOverrides(&resp.Schema, []SchemaOverride{{Name: r.credential, WriteOnly: true}})
+}
+
+// ConfigValidators requires the credential or its write-only variant, so a missing one
+// fails at plan time rather than with a 400 from create.
+func (r *saasSourceResource[M, S, P]) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(path.MatchRoot(r.credential), path.MatchRoot(r.credential+"_wo")),
+	}
+}
+
+func (r *saasSourceResource[M, S, P]) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+func (r *saasSourceResource[M, S, P]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data M
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := P(&data).toAPI(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source, err := client.RestDo[S](ctx, r.rest, http.MethodPost, r.path, input)
+	if handleCreateError(resp, r.name, err) {
+		return
+	}
+	P(&data).set(ctx, source, &resp.Diagnostics)
+	tflog.Debug(ctx, "Created "+r.name, map[string]any{"id": P(&data).core().Id.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *saasSourceResource[M, S, P]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data M
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := P(&data).core().Id.ValueString()
+	source, err := client.RestDo[S](ctx, r.rest, http.MethodGet, r.path+"/"+id, nil)
+	if handleReadError(ctx, resp, r.name, id, err) {
+		return
+	}
+	tflog.Debug(ctx, "Read "+r.name, map[string]any{"id": id})
+
+	P(&data).set(ctx, source, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *saasSourceResource[M, S, P]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data M
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := P(&data).toAPI(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := P(&data).core().Id.ValueString()
+	source, err := client.RestDo[S](ctx, r.rest, http.MethodPut, r.path+"/"+id, input)
+	if handleUpdateError(ctx, resp, r.name, id, err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated "+r.name, map[string]any{"id": id})
+
+	P(&data).set(ctx, source, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *saasSourceResource[M, S, P]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data M
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := P(&data).core().Id.ValueString()
+	err := client.RestDelete(ctx, r.rest, r.path+"/"+id)
+	if handleDeleteError(resp, r.name, id, err) {
+		return
+	}
+	tflog.Debug(ctx, "Deleted "+r.name, map[string]any{"id": id})
+}
+
+func (r *saasSourceResource[M, S, P]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// saasSourceModel holds the attributes every SaaS puller has; each resource's model
+// embeds it.
+type saasSourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	IntegrationLabel types.String `tfsdk:"integration_label"`
+	LogTypes         types.List   `tfsdk:"log_types"`
+}
+
+// saasSourceAttributes returns the schema attributes every SaaS puller has.
+func saasSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The source ID. Use it as the `source_id` of a `panther_log_source_alarm`.",
+			PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+		},
+		"integration_label": schema.StringAttribute{
+			Required:    true,
+			Description: "The integration label (name)",
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"log_types": schema.ListAttribute{
+			ElementType:   types.StringType,
+			Computed:      true,
+			Description:   "The log types Panther ingests from the integration. Panther picks them; they can't be configured.",
+			PlanModifiers: []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+		},
+	}
+}
+
+// credentialAttribute is a sensitive credential the API never returns. It defaults to ""
+// so that the <name>_wo variant added by SchemaOverride.WriteOnly can stand in for it;
+// the ConfigValidators of saasSourceResource and destinationResource require one of the two.
+func credentialAttribute(description string, validators ...validator.String) schema.StringAttribute {
+	description += " Required unless the write-only variant is set. Panther never returns it, so changes made outside Terraform aren't detected."
+	return schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Sensitive:           true,
+		Default:             stringdefault.StaticString(""),
+		Description:         description,
+		MarkdownDescription: description,
+		Validators:          validators,
+	}
+}
+
+// pullIntervalAttribute is how often a puller polls its third party.
+func pullIntervalAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     int64default.StaticInt64(defaultPullIntervalMinutes),
+		Description: "How often, in minutes, Panther polls for new events. Between 1 and 60; defaults to 5.",
+		Validators:  []validator.Int64{int64validator.Between(1, 60)},
+	}
+}
+
+func (m *saasSourceModel) core() *saasSourceModel {
+	return m
+}
+
+func (m saasSourceModel) toCore() client.SaasSourceCore {
+	return client.SaasSourceCore{
+		IntegrationId:    m.Id.ValueString(),
+		IntegrationLabel: m.IntegrationLabel.ValueString(),
+	}
+}
+
+// setCore copies the shared fields of an API response into the model. Credentials are
+// left to each resource: the API returns them blank, so the configured value is kept.
+func (m *saasSourceModel) setCore(ctx context.Context, core client.SaasSourceCore, diagnostics *diag.Diagnostics) {
+	m.Id = types.StringValue(core.IntegrationId)
+	m.IntegrationLabel = types.StringValue(core.IntegrationLabel)
+	m.LogTypes = stringSliceToList(ctx, nonNilStrings(core.LogTypes), diagnostics)
+}
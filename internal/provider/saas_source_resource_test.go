@@ -0,0 +1,300 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"terraform-provider-panther/internal/client/fake"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+const testGoogleServiceAccountKey = `{"type":"service_account","client_email":"panther@example.iam.gserviceaccount.com"}`
+
+// TestSaasSourceResources covers create, import and update for every SaaS puller.
+// Panther picks the log types, so they're only checked, never configured.
+func TestSaasSourceResources(t *testing.T) {
+	cases := []struct {
+		kind     string
+		fields   string
+		secret   string
+		logType  string
+		interval bool
+	}{
+		{"okta", `okta_domain = "example.okta.com"
+  api_token   = "s3cr3t"`, "api_token", "Okta.SystemLog", true},
+		{"github", `organization          = "panther-labs"
+  personal_access_token = "ghp_s3cr3t"`, "personal_access_token", "GitHub.Audit", true},
+		{"slack", `enterprise_id = "E0123ABCDEF"
+  access_token  = "xoxp-s3cr3t"`, "access_token", "Slack.AuditLogs", true},
+		{"google_workspace", fmt.Sprintf(`customer_id         = "C03az79cb"
+  admin_email         = "admin@example.com"
+  service_account_key = %q`, testGoogleServiceAccountKey), "service_account_key", "GSuite.Reports", false},
+		{"onepassword", `events_api_host = "events.1password.eu"
+  bearer_token    = "s3cr3t"`, "bearer_token", "OnePassword.AuditEvent", true},
+		{"microsoft365", fmt.Sprintf(`tenant_id     = %q
+  client_id     = %q
+  client_secret = "s3cr3t"`, testAzureTenantID, testAzureClientID), "client_secret", "Microsoft365.Audit.AzureActiveDirectory", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.kind, func(t *testing.T) {
+			label := "test-" + tc.kind + "-" + uuid.NewString()
+			address := "panther_" + tc.kind + "_source.test"
+			config := func(label, extra string) string {
+				return providerConfig + fmt.Sprintf(`
+resource "panther_%s_source" "test" {
+  integration_label = %q
+  %s
+  %s
+}
+`, tc.kind, label, tc.fields, extra)
+			}
+			created := resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet(address, "id"),
+				resource.TestCheckResourceAttr(address, "integration_label", label),
+				resource.TestCheckResourceAttr(address, "log_types.0", tc.logType),
+			)
+			updated := resource.TestCheckResourceAttr(address, "integration_label", label+"-renamed")
+			extra := ""
+			if tc.interval {
+				created = resource.ComposeAggregateTestCheckFunc(created,
+					resource.TestCheckResourceAttr(address, "pull_interval_minutes", "5"))
+				updated = resource.ComposeAggregateTestCheckFunc(updated,
+					resource.TestCheckResourceAttr(address, "pull_interval_minutes", "15"))
+				extra = "pull_interval_minutes = 15"
+			}
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: config(label, ""),
+						Check:  created,
+					},
+					{
+						ResourceName:            address,
+						ImportState:             true,
+						ImportStateVerify:       true,
+						ImportStateVerifyIgnore: []string{tc.secret},
+					},
+					{
+						Config: config(label+"-renamed", extra),
+						Check:  updated,
+					},
+				},
+			})
+		})
+	}
+}
+
+// TestSaasSourceResource_WriteOnly creates an Okta source from api_token_wo and rotates
+// the token by bumping api_token_wo_version. The token must never reach state.
+func TestSaasSourceResource_WriteOnly(t *testing.T) {
+	label := "test-okta-wo-" + uuid.NewString()
+	config := func(version int) string {
+		return providerConfig + fmt.Sprintf(`
+resource "panther_okta_source" "test" {
+  integration_label    = %q
+  okta_domain          = "example.okta.com"
+  api_token_wo         = "token-%d"
+  api_token_wo_version = %d
+}
+`, label, version, version)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("panther_okta_source.test", "id"),
+					resource.TestCheckResourceAttr("panther_okta_source.test", "api_token", ""),
+					resource.TestCheckNoResourceAttr("panther_okta_source.test", "api_token_wo"),
+				),
+			},
+			{
+				Config: config(2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_okta_source.test", "api_token_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("panther_okta_source.test", "api_token_wo"),
+				),
+			},
+		},
+	})
+}
+
+// TestSaasSourceResource_MissingCredential checks that every SaaS puller refuses, at
+// plan time, a configuration with neither the credential nor its write-only variant.
+func TestSaasSourceResource_MissingCredential(t *testing.T) {
+	cases := map[string]struct {
+		fields, secret string
+	}{
+		"okta":             {`okta_domain = "example.okta.com"`, "api_token"},
+		"github":           {`organization = "panther-labs"`, "personal_access_token"},
+		"slack":            {`enterprise_id = "E0123ABCDEF"`, "access_token"},
+		"google_workspace": {"customer_id = \"C03az79cb\"\n  admin_email = \"admin@example.com\"", "service_account_key"},
+		"onepassword":      {`events_api_host = "events.1password.eu"`, "bearer_token"},
+		"microsoft365":     {fmt.Sprintf("tenant_id = %q\n  client_id = %q", testAzureTenantID, testAzureClientID), "client_secret"},
+	}
+	for kind, tc := range cases {
+		t.Run(kind, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_%s_source" "test" {
+  integration_label = "plan-time-validation"
+  %s
+}
+`, kind, tc.fields),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(`(?s)Missing Attribute Configuration.*\[` + tc.secret + `,` + tc.secret + `_wo\]`),
+					},
+				},
+			})
+		})
+	}
+}
+
+// TestSaasSourceResource_RejectedCredentials checks that a credential the third party
+// refuses is reported against the attribute, with what to check for the integration.
+// The fake API refuses the value fake.RejectedCredential.
+func TestSaasSourceResource_RejectedCredentials(t *testing.T) {
+	if os.Getenv("PANTHER_FAKE_API") == "" {
+		t.Skip("Skipping: PANTHER_FAKE_API must be set; only the fake API refuses a known credential")
+	}
+	label := "test-okta-rejected-" + uuid.NewString()
+	config := func(token string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "panther_okta_source" "test" {
+  integration_label = %q
+  okta_domain       = "example.okta.com"
+  api_token         = %q
+}
+`, label, token)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(fake.RejectedCredential),
+				ExpectError: regexp.MustCompile(`(?s)Okta Source credentials rejected.*refused the configured\s+` + "`api_token`" + `.*Read-Only\s+Administrator`),
+			},
+			{
+				Config: config("s3cr3t"),
+				Check:  resource.TestCheckResourceAttrSet("panther_okta_source.test", "id"),
+			},
+			{
+				Config:      config(fake.RejectedCredential),
+				ExpectError: regexp.MustCompile(`Okta Source credentials rejected`),
+			},
+		},
+	})
+}
+
+// TestSaasSourceResource_Alarm attaches a log source alarm to a SaaS puller by its id.
+func TestSaasSourceResource_Alarm(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "panther_github_source" "test" {
+  integration_label     = "test-github-alarm-%s"
+  organization          = "panther-labs"
+  personal_access_token = "ghp_s3cr3t"
+}
+
+resource "panther_log_source_alarm" "test" {
+  source_id         = panther_github_source.test.id
+  type              = "SOURCE_NO_DATA"
+  minutes_threshold = 120
+}
+`, uuid.NewString()),
+				Check: resource.TestCheckResourceAttrPair("panther_log_source_alarm.test", "source_id", "panther_github_source.test", "id"),
+			},
+		},
+	})
+}
+
+// TestSaasSourceResource_PlanTimeValidation covers errors raised before any API call.
+func TestSaasSourceResource_PlanTimeValidation(t *testing.T) {
+	cases := []struct {
+		name        string
+		kind        string
+		attributes  string
+		expectError string
+	}{
+		{"okta_domain_scheme", "okta", `okta_domain = "https://example.okta.com"
+  api_token   = "s3cr3t"`, `must be a domain without a\s+scheme`},
+		{"pull_interval", "okta", `okta_domain           = "example.okta.com"
+  api_token             = "s3cr3t"
+  pull_interval_minutes = 0`, `value must be between 1 and\s+60`},
+		{"github_token", "github", `organization          = "panther-labs"
+  personal_access_token = "s3cr3t"`, `must be a classic \(ghp_...\) or\s+fine-grained`},
+		{"slack_enterprise_id", "slack", `enterprise_id = "T0123ABCDEF"
+  access_token  = "xoxp-s3cr3t"`, `must be an Enterprise Grid\s+organization\s+ID`},
+		{"slack_bot_token", "slack", `enterprise_id = "E0123ABCDEF"
+  access_token  = "xoxb-s3cr3t"`, `must be a user token`},
+		{"google_admin_email", "google_workspace", `customer_id         = "C03az79cb"
+  admin_email         = "admin"
+  service_account_key = "{}"`, `must be an email\s+address`},
+		{"google_key", "google_workspace", `customer_id         = "C03az79cb"
+  admin_email         = "admin@example.com"
+  service_account_key = "not json"`, `(?i)json`},
+		{"onepassword_host", "onepassword", `events_api_host = "my.1password.com"
+  bearer_token    = "s3cr3t"`, `value must be one\s+of`},
+		{"microsoft365_tenant", "microsoft365", `tenant_id     = "contoso.onmicrosoft.com"
+  client_id     = "0b4f2c1e-6d7a-4c3b-9e8f-1a2b3c4d5e6f"
+  client_secret = "s3cr3t"`, `must be a tenant ID`},
+		{"token_and_wo", "okta", `okta_domain          = "example.okta.com"
+  api_token            = "s3cr3t"
+  api_token_wo         = "s3cr3t"
+  api_token_wo_version = 1`, `(?s)api_token_wo.*cannot be specified when`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_11_0),
+				},
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_%s_source" "test" {
+  integration_label = "test-validation"
+  %s
+}
+`, tc.kind, tc.attributes),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.expectError),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestSavedQueryResource covers create, import, a formatting-only SQL edit that must
+// plan no changes, and an update.
+func TestSavedQueryResource(t *testing.T) {
+	name := "test-saved-query-" + uuid.NewString()
+	config := func(description, sql string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "panther_saved_query" "test" {
+  name        = %q
+  description = %q
+  sql         = %q
+}
+`, name, description, sql)
+	}
+	const sql = "SELECT *\nFROM panther_logs.public.aws_cloudtrail\nWHERE p_occurs_since('1 day')"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("", sql),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("panther_saved_query.test", "id"),
+					resource.TestCheckResourceAttr("panther_saved_query.test", "name", name),
+					resource.TestCheckResourceAttr("panther_saved_query.test", "description", ""),
+					resource.TestCheckResourceAttr("panther_saved_query.test", "sql", sql),
+				),
+			},
+			{
+				ResourceName:      "panther_saved_query.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   config("", "SELECT *  \r\nFROM panther_logs.public.aws_cloudtrail\r\nWHERE p_occurs_since('1 day')\n"),
+				PlanOnly: true,
+			},
+			{
+				Config: config("CloudTrail events from the last week", "SELECT *\nFROM panther_logs.public.aws_cloudtrail\nWHERE p_occurs_since('7 days')"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_saved_query.test", "description", "CloudTrail events from the last week"),
+					resource.TestCheckResourceAttr("panther_saved_query.test", "sql", "SELECT *\nFROM panther_logs.public.aws_cloudtrail\nWHERE p_occurs_since('7 days')"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const scheduledQueryPath = "/scheduled-queries"
+
+// cronExpressionRegex only checks the shape (five whitespace-separated fields); the
+// API validates the field values.
+var cronExpressionRegex = regexp.MustCompile(`^\S+(\s+\S+){4}$`)
+
+var (
+	_ resource.Resource                     = (*scheduledQueryResource)(nil)
+	_ resource.ResourceWithConfigure        = (*scheduledQueryResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*scheduledQueryResource)(nil)
+	_ resource.ResourceWithImportState      = (*scheduledQueryResource)(nil)
+)
+
+func NewScheduledQueryResource() resource.Resource {
+	return &scheduledQueryResource{}
+}
+
+// scheduledQueryResource manages a scheduled query. Like savedQueryResource it is
+// hand-written, for sqlType and for the mutually exclusive schedule attributes.
+type scheduledQueryResource struct {
+	rest *client.RESTClient
+}
+
+type scheduledQueryModel struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Sql            sqlValue     `tfsdk:"sql"`
+	CronExpression types.String `tfsdk:"cron_expression"`
+	RateMinutes    types.Int64  `tfsdk:"rate_minutes"`
+	TimeoutMinutes types.Int64  `tfsdk:"timeout_minutes"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *scheduledQueryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduled_query"
+}
+
+func (r *scheduledQueryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Panther scheduled query: SQL that runs on a cron or rate schedule and " +
+			"feeds the `panther_scheduled_rule` resources that list it in `scheduled_queries`. " +
+			"Formatting-only changes to `sql` (line endings, trailing whitespace) don't produce a diff.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "ID of the scheduled query",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The unique name of the query. Scheduled rules reference scheduled queries by name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "A description of what the query is for",
+			},
+			"sql": sqlAttribute(),
+			// The schedule is one of two mutually exclusive attributes (see ConfigValidators).
+			// Neither is computed, so switching from one to the other plans the unset one as
+			// null rather than "known after apply".
+			"cron_expression": schema.StringAttribute{
+				Optional:    true,
+				Description: "A 5-field cron expression (UTC) for when the query runs. Mutually exclusive with a rate.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cronExpressionRegex, "must be a 5-field cron expression (e.g. \"0 * * * *\")"),
+				},
+			},
+			"rate_minutes": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Run the query every this many minutes. Mutually exclusive with a cron expression.",
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"timeout_minutes": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(5),
+				Description: "How long, in minutes, the query may run before it is cancelled. Between 1 and 15; defaults to 5.",
+				Validators:  []validator.Int64{int64validator.Between(1, 15)},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the query runs on its schedule",
+			},
+		},
+	}
+}
+
+func (r *scheduledQueryResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(path.MatchRoot("cron_expression"), path.MatchRoot("rate_minutes")),
+	}
+}
+
+func (r *scheduledQueryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+func (r *scheduledQueryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data scheduledQueryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := client.RestDo[client.ScheduledQuery](ctx, r.rest, http.MethodPost, scheduledQueryPath, data.toAPI())
+	if handleCreateError(resp, "Scheduled Query", err) {
+		return
+	}
+	tflog.Debug(ctx, "Created Scheduled Query", map[string]any{"id": out.ID})
+
+	data.set(out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *scheduledQueryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data scheduledQueryModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := client.RestDo[client.ScheduledQuery](ctx, r.rest, http.MethodGet, scheduledQueryPath+"/"+data.Id.ValueString(), nil)
+	if handleReadError(ctx, resp, "Scheduled Query", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Read Scheduled Query", map[string]any{"id": out.ID})
+
+	data.set(out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *scheduledQueryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data scheduledQueryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := client.RestDo[client.ScheduledQuery](ctx, r.rest, http.MethodPut, scheduledQueryPath+"/"+data.Id.ValueString(), data.toAPI())
+	if handleUpdateError(ctx, resp, "Scheduled Query", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated Scheduled Query", map[string]any{"id": data.Id.ValueString()})
+
+	data.set(out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *scheduledQueryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data scheduledQueryModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RestDelete(ctx, r.rest, scheduledQueryPath+"/"+data.Id.ValueString())
+	if handleDeleteError(resp, "Scheduled Query", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Deleted Scheduled Query", map[string]any{"id": data.Id.ValueString()})
+}
+
+func (r *scheduledQueryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m scheduledQueryModel) toAPI() client.ScheduledQueryInput {
+	return client.ScheduledQueryInput{
+		Name:           m.Name.ValueString(),
+		Description:    m.Description.ValueString(),
+		SQL:            m.Sql.ValueString(),
+		CronExpression: m.CronExpression.ValueString(),
+		RateMinutes:    m.RateMinutes.ValueInt64(),
+		TimeoutMinutes: m.TimeoutMinutes.ValueInt64(),
+		Enabled:        m.Enabled.ValueBool(),
+	}
+}
+
+// set copies the API response into the model. The API omits whichever of
+// cronExpression and rateMinutes isn't in use; that attribute is stored as null.
+func (m *scheduledQueryModel) set(out client.ScheduledQuery) {
+	m.Id = types.StringValue(out.ID)
+	m.Name = types.StringValue(out.Name)
+	m.Description = types.StringValue(out.Description)
+	m.Sql = newSQLValue(out.SQL)
+	m.CronExpression = types.StringNull()
+	if out.CronExpression != "" {
+		m.CronExpression = types.StringValue(out.CronExpression)
+	}
+	m.RateMinutes = types.Int64Null()
+	if out.RateMinutes != 0 {
+		m.RateMinutes = types.Int64Value(out.RateMinutes)
+	}
+	m.TimeoutMinutes = types.Int64Value(out.TimeoutMinutes)
+	m.Enabled = types.BoolValue(out.Enabled)
+}
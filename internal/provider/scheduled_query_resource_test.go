@@ -0,0 +1,108 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestScheduledQueryResource covers create with defaults, import, a formatting-only SQL
+// edit, and switching the schedule from cron to rate.
+func TestScheduledQueryResource(t *testing.T) {
+	name := "test-scheduled-query-" + uuid.NewString()
+	config := func(sql, schedule string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "panther_scheduled_query" "test" {
+  name = %q
+  sql  = %q
+  %s
+}
+`, name, sql, schedule)
+	}
+	const sql = "SELECT count(*)\nFROM panther_logs.public.okta_systemlog\nWHERE p_occurs_since('1 hour')"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(sql, `cron_expression = "0 * * * *"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("panther_scheduled_query.test", "id"),
+					resource.TestCheckResourceAttr("panther_scheduled_query.test", "cron_expression", "0 * * * *"),
+					resource.TestCheckNoResourceAttr("panther_scheduled_query.test", "rate_minutes"),
+					resource.TestCheckResourceAttr("panther_scheduled_query.test", "timeout_minutes", "5"),
+					resource.TestCheckResourceAttr("panther_scheduled_query.test", "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "panther_scheduled_query.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   config(sql+"\r\n\r\n", `cron_expression = "0 * * * *"`),
+				PlanOnly: true,
+			},
+			{
+				Config: config(sql, "rate_minutes    = 30\n  timeout_minutes = 10\n  enabled         = false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("panther_scheduled_query.test", "cron_expression"),
+					resource.TestCheckResourceAttr("panther_scheduled_query.test", "rate_minutes", "30"),
+					resource.TestCheckResourceAttr("panther_scheduled_query.test", "timeout_minutes", "10"),
+					resource.TestCheckResourceAttr("panther_scheduled_query.test", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduledQueryResource_ScheduleValidation(t *testing.T) {
+	cases := []struct {
+		name        string
+		schedule    string
+		expectError string
+	}{
+		{"neither", ``, `(?s)Exactly one of these attributes must be configured.*cron_expression,rate_minutes`},
+		{"both", "cron_expression = \"0 * * * *\"\n  rate_minutes    = 60", `Invalid Attribute Combination`},
+		{"cron_fields", `cron_expression = "0 * * *"`, `must be a 5-field cron expression`},
+		{"timeout", "rate_minutes    = 60\n  timeout_minutes = 30", `timeout_minutes value must be between 1 and 15`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_scheduled_query" "test" {
+  name = "test-scheduled-query-validation"
+  sql  = "SELECT 1"
+  %s
+}
+`, tc.schedule),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.expectError),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"maps"
+	"net/http"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*scheduledRuleResource)(nil)
+	_ resource.ResourceWithConfigure   = (*scheduledRuleResource)(nil)
+	_ resource.ResourceWithImportState = (*scheduledRuleResource)(nil)
+)
+
+func NewScheduledRuleResource() resource.Resource {
+	return &scheduledRuleResource{}
+}
+
+type scheduledRuleResource struct {
+	rest *client.RESTClient
+}
+
+type scheduledRuleModel struct {
+	detectionModel
+	ScheduledQueries   types.List  `tfsdk:"scheduled_queries"`
+	DedupPeriodMinutes types.Int64 `tfsdk:"dedup_period_minutes"`
+	Threshold          types.Int64 `tfsdk:"threshold"`
+}
+
+func (r *scheduledRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduled_rule"
+}
+
+func (r *scheduledRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := detectionAttributes("event")
+	maps.Copy(attributes, alertGroupingAttributes())
+	attributes["scheduled_queries"] = requiredStringListAttribute("The names of the scheduled queries whose results the " +
+		"rule runs on, e.g. `panther_scheduled_query` names.")
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Panther scheduled rule: a Python detection run against each row returned by " +
+			"its scheduled queries.",
+		Attributes: attributes,
+	}
+}
+
+func (r *scheduledRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+func (r *scheduledRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data scheduledRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := data.toAPI(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := client.RestDo[client.ScheduledRule](ctx, r.rest, http.MethodPost, scheduledRulePath, input)
+	if handleCreateError(resp, "Scheduled Rule", err) {
+		return
+	}
+	tflog.Debug(ctx, "Created Scheduled Rule", map[string]any{"id": rule.ID})
+
+	data.set(ctx, rule, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *scheduledRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data scheduledRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := client.RestDo[client.ScheduledRule](ctx, r.rest, http.MethodGet, scheduledRulePath+"/"+data.Id.ValueString(), nil)
+	if handleReadError(ctx, resp, "Scheduled Rule", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Read Scheduled Rule", map[string]any{"id": rule.ID})
+
+	data.set(ctx, rule, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *scheduledRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data scheduledRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := data.toAPI(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := client.RestDo[client.ScheduledRule](ctx, r.rest, http.MethodPut, scheduledRulePath+"/"+data.Id.ValueString(), input)
+	if handleUpdateError(ctx, resp, "Scheduled Rule", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated Scheduled Rule", map[string]any{"id": data.Id.ValueString()})
+
+	data.set(ctx, rule, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *scheduledRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data scheduledRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RestDelete(ctx, r.rest, scheduledRulePath+"/"+data.Id.ValueString())
+	if handleDeleteError(resp, "Scheduled Rule", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Deleted Scheduled Rule", map[string]any{"id": data.Id.ValueString()})
+}
+
+func (r *scheduledRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m scheduledRuleModel) toAPI(ctx context.Context, diagnostics *diag.Diagnostics) client.ScheduledRule {
+	return client.ScheduledRule{
+		DetectionCore:      m.toCore(ctx, diagnostics),
+		ScheduledQueries:   listToStringSlice(ctx, m.ScheduledQueries, diagnostics),
+		DedupPeriodMinutes: m.DedupPeriodMinutes.ValueInt64(),
+		Threshold:          m.Threshold.ValueInt64(),
+	}
+}
+
+func (m *scheduledRuleModel) set(ctx context.Context, rule client.ScheduledRule, diagnostics *diag.Diagnostics) {
+	m.setCore(ctx, rule.DetectionCore, diagnostics)
+	m.ScheduledQueries = stringSliceToList(ctx, nonNilStrings(rule.ScheduledQueries), diagnostics)
+	m.DedupPeriodMinutes = types.Int64Value(rule.DedupPeriodMinutes)
+	m.Threshold = types.Int64Value(rule.Threshold)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestScheduledRuleResource(t *testing.T) {
+	id := "Test.ScheduledRule." + strings.ReplaceAll(uuid.NewString(), "-", "")
+	queryName := "test-scheduled-rule-query-" + uuid.NewString()
+	config := func(severity, extra string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "panther_scheduled_query" "test" {
+  name            = %q
+  sql             = "SELECT 1"
+  cron_expression = "0 * * * *"
+}
+
+resource "panther_scheduled_rule" "test" {
+  id                = %q
+  severity          = %q
+  scheduled_queries = [panther_scheduled_query.test.name]
+  body              = "def rule(event):\n    return True\n"
+  %s
+}
+`, queryName, id, severity, extra)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("LOW", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_scheduled_rule.test", "id", id),
+					resource.TestCheckResourceAttr("panther_scheduled_rule.test", "scheduled_queries.0", queryName),
+					resource.TestCheckResourceAttr("panther_scheduled_rule.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("panther_scheduled_rule.test", "dedup_period_minutes", "60"),
+				),
+			},
+			{
+				ResourceName:      "panther_scheduled_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config("HIGH", `tags = ["updated"]
+  threshold         = 10`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_scheduled_rule.test", "severity", "HIGH"),
+					resource.TestCheckResourceAttr("panther_scheduled_rule.test", "tags.0", "updated"),
+					resource.TestCheckResourceAttr("panther_scheduled_rule.test", "threshold", "10"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*schemaResource)(nil)
+	_ resource.ResourceWithConfigure   = (*schemaResource)(nil)
+	_ resource.ResourceWithImportState = (*schemaResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*schemaResource)(nil)
+)
+
+func NewSchemaResource() resource.Resource {
+	return &schemaResource{}
+}
+
+// schemaResource manages a custom log schema. It is hand-written: the schema
+// endpoints are keyed by name rather than an integration ID, and the API never
+// deletes a schema, only archives it.
+type schemaResource struct {
+	rest *client.RESTClient
+}
+
+type schemaModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	ReferenceURL types.String `tfsdk:"reference_url"`
+	Spec         yamlValue    `tfsdk:"spec"`
+}
+
+func (r *schemaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema"
+}
+
+func (r *schemaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a custom log schema. Panther never deletes schemas: destroying this resource " +
+			"archives the schema, and creating one whose name matches an archived schema restores it with the " +
+			"configured spec.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The schema name.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The log type name, which log sources reference in `log_types`. Must start with " +
+					"`Custom.`. Changing this forces a new schema (the old one is archived).",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^Custom\.[0-9A-Za-z_.]+$`),
+						`must start with "Custom." followed by letters, digits, dots and underscores`,
+					),
+				},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "A description of the log type.",
+			},
+			"reference_url": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "A link to documentation for the log type.",
+			},
+			"spec": schema.StringAttribute{
+				Required:   true,
+				CustomType: yamlType{},
+				MarkdownDescription: "The schema definition in YAML. Compared semantically with the schema stored " +
+					"in Panther, so the API reordering keys or reformatting the document does not show up as drift.",
+			},
+		},
+	}
+}
+
+func (r *schemaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+// ModifyPlan tells the client about the planned log type, so sources planned in the
+// same run that reference this schema's name pass validateLogTypes.
+func (r *schemaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.rest == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	var name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if !name.IsNull() && !name.IsUnknown() {
+		r.rest.PlanLogType(name.ValueString())
+	}
+}
+
+func (r *schemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data schemaModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := schemaInput(data)
+	input.Name = data.Name.ValueString()
+	result, err := client.RestDo[client.Schema](ctx, r.rest, http.MethodPost, client.SchemasPath, input)
+	if client.IsConflict(err) {
+		result, err = r.restoreArchived(ctx, input, err)
+	}
+	if addSchemaValidationError(&resp.Diagnostics, data.Name.ValueString(), err) {
+		return
+	}
+	if handleCreateError(resp, "Schema", err) {
+		return
+	}
+	tflog.Debug(ctx, "Created Schema", map[string]any{"name": result.Name})
+
+	setSchemaModel(&data, result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// restoreArchived handles a 409 from POST: if the name belongs to an archived custom
+// schema (typically one this resource destroyed earlier), restore it with the planned
+// attributes. Otherwise the original conflict is returned.
+func (r *schemaResource) restoreArchived(ctx context.Context, input client.SchemaInput, conflict error) (client.Schema, error) {
+	existing, err := client.RestDo[client.Schema](ctx, r.rest, http.MethodGet, schemaPath(input.Name), nil)
+	if err != nil || !existing.IsArchived || existing.IsManaged {
+		return client.Schema{}, conflict
+	}
+	tflog.Info(ctx, "Restoring archived Schema", map[string]any{"name": input.Name})
+	return client.RestDo[client.Schema](ctx, r.rest, http.MethodPut, schemaPath(input.Name), input)
+}
+
+func (r *schemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data schemaModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := client.RestDo[client.Schema](ctx, r.rest, http.MethodGet, schemaPath(data.Id.ValueString()), nil)
+	if handleReadError(ctx, resp, "Schema", data.Id.ValueString(), err) {
+		return
+	}
+	// Archived is this resource's deleted: drop it so the next apply restores it.
+	if result.IsArchived {
+		tflog.Warn(ctx, fmt.Sprintf("Schema %s was archived, removing from state", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	setSchemaModel(&data, result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *schemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data schemaModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := client.RestDo[client.Schema](ctx, r.rest, http.MethodPut, schemaPath(data.Id.ValueString()), schemaInput(data))
+	if addSchemaValidationError(&resp.Diagnostics, data.Name.ValueString(), err) {
+		return
+	}
+	if handleUpdateError(ctx, resp, "Schema", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated Schema", map[string]any{"name": result.Name})
+
+	setSchemaModel(&data, result)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete archives the schema; the API has no way to delete one.
+func (r *schemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data schemaModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := schemaInput(data)
+	input.IsArchived = true
+	_, err := client.RestDo[client.Schema](ctx, r.rest, http.MethodPut, schemaPath(data.Id.ValueString()), input)
+	if handleDeleteError(resp, "Schema", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Archived Schema", map[string]any{"name": data.Id.ValueString()})
+}
+
+func (r *schemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+func schemaPath(name string) string {
+	return client.SchemasPath + "/" + url.PathEscape(name)
+}
+
+func schemaInput(data schemaModel) client.SchemaInput {
+	return client.SchemaInput{
+		Description:  data.Description.ValueString(),
+		ReferenceURL: data.ReferenceURL.ValueString(),
+		Spec:         data.Spec.ValueString(),
+	}
+}
+
+// setSchemaModel copies an API response into the model. The API re-serializes the
+// spec; yamlValue's semantic equality keeps the configured text when it's unchanged.
+func setSchemaModel(data *schemaModel, s client.Schema) {
+	data.Id = types.StringValue(s.Name)
+	data.Name = types.StringValue(s.Name)
+	data.Description = types.StringValue(s.Description)
+	data.ReferenceURL = types.StringValue(s.ReferenceURL)
+	data.Spec = newYAMLValue(s.Spec)
+}
+
+// addSchemaValidationError reports a 400 (the API's schema validation failed) against
+// spec with the API's message on its own, so the problem isn't buried in request details.
+func addSchemaValidationError(diagnostics *diag.Diagnostics, name string, err error) bool {
+	var apiErr *client.APIError
+	if !client.IsBadRequest(err) || !errors.As(err, &apiErr) {
+		return false
+	}
+	diagnostics.AddAttributeError(
+		path.Root("spec"),
+		"Invalid Schema",
+		fmt.Sprintf("Panther rejected the schema %q:\n\n%s", name, apiErr.Message),
+	)
+	return true
+}
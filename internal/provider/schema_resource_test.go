@@ -0,0 +1,163 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestSchemaResource covers the custom schema lifecycle: create, import, update, a spec
+// the API rejects, and archive on destroy. The spec is written with keys out of the
+// order the API returns them in, so every step also checks that the re-serialized
+// spec doesn't surface as a diff.
+func TestSchemaResource(t *testing.T) {
+	name := "Custom.Test" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testSchemaResourceConfig(name, "Firewall events", `
+# Emitted by the edge firewall.
+fields:
+  - type: timestamp
+    name: time
+    isEventTime: true
+    timeFormats: [rfc3339]
+  - type: string
+    name: action
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_schema.test", "id", name),
+					resource.TestCheckResourceAttr("panther_schema.test", "name", name),
+					resource.TestCheckResourceAttr("panther_schema.test", "description", "Firewall events"),
+					resource.TestCheckResourceAttr("panther_schema.test", "reference_url", ""),
+					resource.TestCheckResourceAttr("panther_schema.test", "spec", `
+# Emitted by the edge firewall.
+fields:
+  - type: timestamp
+    name: time
+    isEventTime: true
+    timeFormats: [rfc3339]
+  - type: string
+    name: action
+`),
+				),
+			},
+			{
+				ResourceName:      "panther_schema.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The imported spec is the API's serialization, not the configured text.
+				ImportStateVerifyIgnore: []string{"spec"},
+			},
+			{
+				Config: providerConfig + testSchemaResourceConfig(name, "Edge firewall events", `
+fields:
+  - name: time
+    type: timestamp
+    isEventTime: true
+    timeFormats: [rfc3339]
+  - name: action
+    type: string
+  - name: srcIp
+    type: string
+    indicators: [ip]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_schema.test", "description", "Edge firewall events"),
+					resource.TestMatchResourceAttr("panther_schema.test", "spec", regexp.MustCompile(`srcIp`)),
+				),
+			},
+			{
+				Config: providerConfig + testSchemaResourceConfig(name, "Edge firewall events", `
+fields:
+  - name: time
+    type: timestamp
+  - name: action
+`),
+				ExpectError: regexp.MustCompile(`(?s)Invalid Schema.*fields\[1\]: type is required`),
+			},
+		},
+	})
+}
+
+// TestSchemaResource_RestoresArchived destroys (archives) a schema and creates it again
+// under the same name, which must restore it rather than fail with a conflict.
+func TestSchemaResource_RestoresArchived(t *testing.T) {
+	name := "Custom.Test" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	config := providerConfig + testSchemaResourceConfig(name, "v1", "fields:\n  - name: msg\n    type: string\n")
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{Config: config},
+			{Config: providerConfig, Destroy: true},
+			{
+				Config: providerConfig + testSchemaResourceConfig(name, "v2", "fields:\n  - name: msg\n    type: string\n"),
+				Check:  resource.TestCheckResourceAttr("panther_schema.test", "description", "v2"),
+			},
+		},
+	})
+}
+
+// TestSchemaResource_PlanTimeValidation covers errors raised before any API call: bad
+// YAML, a name outside the Custom. namespace, and (the success case) a source
+// referencing a schema that is only planned, which must pass log type validation.
+func TestSchemaResource_PlanTimeValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + testSchemaResourceConfig("Custom.Broken", "", "fields: ["),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid YAML`),
+			},
+			{
+				Config:      providerConfig + testSchemaResourceConfig("Firewall", "", "fields: []"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must start with "Custom."`),
+			},
+			{
+				Config: providerConfig + testSchemaResourceConfig("Custom.NotYetDeployed", "", "fields:\n  - name: msg\n    type: string\n") + `
+resource "panther_httpsource" "test" {
+  integration_label = "planned-schema"
+  log_stream_type   = "Auto"
+  log_types         = [panther_schema.test.name]
+  auth_method       = "None"
+}
+`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testSchemaResourceConfig(name, description, spec string) string {
+	return fmt.Sprintf(`
+resource "panther_schema" "test" {
+  name        = %q
+  description = %q
+  spec        = %q
+}
+`, name, description, spec)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// stringFormat is the content of a semanticStringType, implemented by a zero-size type
+// per format (yamlFormat, jsonFormat, sqlFormat).
+type stringFormat interface {
+	comparable
+	// typeName names the attribute type, e.g. "yamlType".
+	typeName() string
+	// decode returns a form of s that is the same, by reflect.DeepEqual, for every
+	// spelling of the same content, or an error if s isn't valid.
+	decode(s string) (any, error)
+	// invalid returns the summary and detail of the error for a value decode rejected.
+	invalid(err error) (summary, detail string)
+}
+
+// semanticStringType is a string attribute type whose values are compared by content:
+// two strings F decodes to the same data are semantically equal, so formatting
+// differences and the API re-serializing a value don't show up as drift.
+type semanticStringType[F stringFormat] struct {
+	basetypes.StringType
+}
+
+func (t semanticStringType[F]) Equal(o attr.Type) bool {
+	other, ok := o.(semanticStringType[F])
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t semanticStringType[F]) String() string {
+	var format F
+	return format.typeName()
+}
+
+func (t semanticStringType[F]) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return semanticStringValue[F]{StringValue: in}, nil
+}
+
+func (t semanticStringType[F]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return semanticStringValue[F]{StringValue: stringValue}, nil
+}
+
+func (t semanticStringType[F]) ValueType(_ context.Context) attr.Value {
+	return semanticStringValue[F]{}
+}
+
+type semanticStringValue[F stringFormat] struct {
+	basetypes.StringValue
+}
+
+func (v semanticStringValue[F]) Type(_ context.Context) attr.Type {
+	return semanticStringType[F]{}
+}
+
+func (v semanticStringValue[F]) Equal(o attr.Value) bool {
+	other, ok := o.(semanticStringValue[F])
+	return ok && v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values decode to the same data. A value
+// that doesn't decode is only equal to itself, by the framework's plain comparison.
+func (v semanticStringValue[F]) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(semanticStringValue[F])
+	if !ok {
+		diags.AddError("Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T. Please report this to the provider developers.", v, newValuable))
+		return false, diags
+	}
+	var format F
+	oldData, err := format.decode(v.ValueString())
+	if err != nil {
+		return false, nil
+	}
+	newData, err := format.decode(newValue.ValueString())
+	if err != nil {
+		return false, nil
+	}
+	return reflect.DeepEqual(oldData, newData), nil
+}
+
+func (v semanticStringValue[F]) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	var format F
+	if _, err := format.decode(v.ValueString()); err != nil {
+		summary, detail := format.invalid(err)
+		resp.Diagnostics.AddAttributeError(req.Path, summary, detail)
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const slackSourcePath = "/log-sources/slack"
+
+var (
+	slackEnterpriseIDRegex = regexp.MustCompile(`^E[A-Z0-9]{8,}$`)
+	slackUserTokenRegex    = regexp.MustCompile(`^xoxp-[A-Za-z0-9-]+$`)
+)
+
+func NewSlackSourceResource() resource.Resource {
+	return &saasSourceResource[slackSourceModel, client.SlackSource, *slackSourceModel]{
+		typeName:   "_slack_source",
+		name:       "Slack Source",
+		path:       slackSourcePath,
+		credential: "access_token",
+		schema:     slackSourceSchema,
+	}
+}
+
+// slackSourceModel adds the Slack fields to saasSourceModel. The *Wo fields
+// are only ever set in config; see secretValue.
+type slackSourceModel struct {
+	saasSourceModel
+	EnterpriseId         types.String `tfsdk:"enterprise_id"`
+	AccessToken          types.String `tfsdk:"access_token"`
+	AccessTokenWo        types.String `tfsdk:"access_token_wo"`
+	AccessTokenWoVersion types.Int64  `tfsdk:"access_token_wo_version"`
+	PullIntervalMinutes  types.Int64  `tfsdk:"pull_interval_minutes"`
+}
+
+func slackSourceSchema() schema.Schema {
+	attributes := saasSourceAttributes()
+	attributes["enterprise_id"] = schema.StringAttribute{
+		Required:    true,
+		Description: "The ID of the Enterprise Grid organization (starts with E)",
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(slackEnterpriseIDRegex, "must be an Enterprise Grid organization ID, e.g. E0123ABCDEF"),
+		},
+	}
+	attributes["access_token"] = credentialAttribute("A user token of an Org Owner with the auditlogs:read scope.", stringvalidator.RegexMatches(slackUserTokenRegex, "must be a user token (xoxp-...)"))
+	attributes["pull_interval_minutes"] = pullIntervalAttribute()
+	return schema.Schema{
+		MarkdownDescription: "Manages a Slack log source that pulls the audit logs of a Slack Enterprise Grid organization.",
+		Attributes:          attributes,
+	}
+}
+
+func (m slackSourceModel) toAPI(ctx context.Context, config tfsdk.Config, diagnostics *diag.Diagnostics) client.SlackSource {
+	return client.SlackSource{
+		SaasSourceCore:      m.toCore(),
+		EnterpriseId:        m.EnterpriseId.ValueString(),
+		AccessToken:         secretValue(ctx, config, "access_token", m.AccessToken, diagnostics),
+		PullIntervalMinutes: m.PullIntervalMinutes.ValueInt64(),
+	}
+}
+
+func (m *slackSourceModel) set(ctx context.Context, source client.SlackSource, diagnostics *diag.Diagnostics) {
+	m.setCore(ctx, source.SaasSourceCore, diagnostics)
+	m.EnterpriseId = types.StringValue(source.EnterpriseId)
+	m.PullIntervalMinutes = types.Int64Value(source.PullIntervalMinutes)
+}
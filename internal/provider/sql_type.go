@@ -0,0 +1,104 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = sqlType{}
+	_ basetypes.StringValuableWithSemanticEquals = sqlValue{}
+	_ planmodifier.String                        = sqlFormattingPlanModifier{}
+)
+
+// sqlType is a string attribute type holding a SQL query. Queries that are the same
+// after normalizeSQL are semantically equal, so the API normalizing the text it stores
+// doesn't cause drift.
+type (
+	sqlType  = semanticStringType[sqlFormat]
+	sqlValue = semanticStringValue[sqlFormat]
+)
+
+type sqlFormat struct{}
+
+func newSQLValue(s string) sqlValue {
+	return sqlValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+func (sqlFormat) typeName() string {
+	return "sqlType"
+}
+
+// decode never fails: any text is a query as far as the provider is concerned.
+func (sqlFormat) decode(s string) (any, error) {
+	return normalizeSQL(s), nil
+}
+
+func (sqlFormat) invalid(error) (string, string) {
+	return "Invalid SQL", "The value is not a valid SQL query."
+}
+
+// normalizeSQL converts CRLF line endings to LF and drops trailing whitespace from every
+// line and trailing blank lines. Leading whitespace is kept: indentation can be
+// significant inside string literals.
+func normalizeSQL(sql string) string {
+	lines := strings.Split(strings.ReplaceAll(sql, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+// sqlFormattingPlanModifier plans the prior state's SQL when the configured SQL differs
+// from it only in formatting. Semantic equality alone isn't enough: the framework
+// applies it to apply and read results, not to the plan, so re-wrapping a heredoc
+// would otherwise show an update.
+type sqlFormattingPlanModifier struct{}
+
+func (m sqlFormattingPlanModifier) Description(_ context.Context) string {
+	return "Ignores line-ending and trailing-whitespace differences from the prior state."
+}
+
+func (m sqlFormattingPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m sqlFormattingPlanModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	if normalizeSQL(req.StateValue.ValueString()) == normalizeSQL(req.PlanValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// sqlAttribute is the required query text of a saved or scheduled query. It has sqlType
+// and sqlFormattingPlanModifier, so formatting-only edits to the query produce no diff.
+func sqlAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Required:      true,
+		CustomType:    sqlType{},
+		Description:   "The Snowflake SQL the query runs",
+		PlanModifiers: []planmodifier.String{sqlFormattingPlanModifier{}},
+	}
+}
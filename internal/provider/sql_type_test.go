@@ -0,0 +1,64 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLValue_SemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	base := newSQLValue("SELECT *\nFROM panther_logs.public.aws_cloudtrail\nWHERE p_occurs_since('1 hour')")
+	cases := map[string]struct {
+		other string
+		equal bool
+	}{
+		"identical":           {"SELECT *\nFROM panther_logs.public.aws_cloudtrail\nWHERE p_occurs_since('1 hour')", true},
+		"crlf":                {"SELECT *\r\nFROM panther_logs.public.aws_cloudtrail\r\nWHERE p_occurs_since('1 hour')", true},
+		"trailing whitespace": {"SELECT *  \nFROM panther_logs.public.aws_cloudtrail\t\nWHERE p_occurs_since('1 hour')\n\n", true},
+		"indentation":         {"SELECT *\n  FROM panther_logs.public.aws_cloudtrail\nWHERE p_occurs_since('1 hour')", false},
+		"changed query":       {"SELECT *\nFROM panther_logs.public.aws_cloudtrail\nWHERE p_occurs_since('2 hours')", false},
+	}
+	for name, tc := range cases {
+		equal, diags := base.StringSemanticEquals(ctx, newSQLValue(tc.other))
+		assert.False(t, diags.HasError(), name)
+		assert.Equal(t, tc.equal, equal, name)
+	}
+}
+
+func TestSQLFormattingPlanModifier(t *testing.T) {
+	ctx := context.Background()
+	state := types.StringValue("SELECT 1\nFROM t")
+	cases := map[string]struct {
+		plan types.String
+		want types.String
+	}{
+		"formatting only": {types.StringValue("SELECT 1  \r\nFROM t\n"), state},
+		"changed":         {types.StringValue("SELECT 2\nFROM t"), types.StringValue("SELECT 2\nFROM t")},
+		"unknown":         {types.StringUnknown(), types.StringUnknown()},
+	}
+	for name, tc := range cases {
+		resp := planmodifier.StringResponse{PlanValue: tc.plan}
+		sqlFormattingPlanModifier{}.PlanModifyString(ctx, planmodifier.StringRequest{StateValue: state, PlanValue: tc.plan}, &resp)
+		assert.Equal(t, tc.want, resp.PlanValue, name)
+	}
+}
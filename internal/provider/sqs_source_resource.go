@@ -0,0 +1,194 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"terraform-provider-panther/internal/client"
+	"terraform-provider-panther/internal/provider/resource_sqs_source"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const sqsSourcePath = "/log-sources/sqs"
+
+// Who may send to the source's queue: IAM principals (an account root, user or role)
+// and, for fan-in from other services, the ARN of the sending resource.
+var (
+	iamPrincipalARNRegex = regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:(?:root|user/.+|role/.+)$`)
+	awsResourceARNRegex  = regexp.MustCompile(`^arn:aws[a-z-]*:[a-z0-9-]+:[a-z0-9-]*:\d{0,12}:.+$`)
+)
+
+var (
+	_ resource.Resource                = (*sqsSourceResource)(nil)
+	_ resource.ResourceWithConfigure   = (*sqsSourceResource)(nil)
+	_ resource.ResourceWithImportState = (*sqsSourceResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*sqsSourceResource)(nil)
+)
+
+func NewSqsSourceResource() resource.Resource {
+	return &sqsSourceResource{}
+}
+
+type sqsSourceResource struct {
+	rest *client.RESTClient
+}
+
+func (r *sqsSourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sqs_source"
+}
+
+func (r *sqsSourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_sqs_source.SqsSourceResourceSchema(ctx)
+	resp.Schema.MarkdownDescription = "Represents an SQS Log Source in Panther. Panther creates the queue; " +
+		"`allowed_principal_arns` and `allowed_source_arns` make up its access policy."
+	applySchemaOverrides(&resp.Schema, []SchemaOverride{
+		{Name: "id", PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+		{Name: "queue_url", PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
+	})
+
+	setEmptyListDefault(&resp.Schema, "allowed_principal_arns")
+	setEmptyListDefault(&resp.Schema, "allowed_source_arns")
+	addListElementValidator(&resp.Schema, "allowed_principal_arns",
+		stringvalidator.RegexMatches(iamPrincipalARNRegex,
+			"must be an IAM account root, user or role ARN (e.g. arn:aws:iam::123456789012:role/log-shipper)"))
+	addListElementValidator(&resp.Schema, "allowed_source_arns",
+		stringvalidator.RegexMatches(awsResourceARNRegex,
+			"must be an AWS resource ARN (e.g. arn:aws:sns:us-east-1:123456789012:app-logs)"))
+}
+
+func (r *sqsSourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validateLogTypes(ctx, r.rest, req, resp, logTypesExpression)
+}
+
+func (r *sqsSourceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+func (r *sqsSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_sqs_source.SqsSourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := sqsSourceInput(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sqsSource, err := client.RestDo[client.SqsSource](ctx, r.rest, http.MethodPost, sqsSourcePath, input)
+	if handleCreateError(resp, "SQS Source", err) {
+		return
+	}
+	tflog.Debug(ctx, "Created SQS Source", map[string]any{
+		"id": sqsSource.IntegrationId,
+	})
+
+	setSqsSource(ctx, &data, sqsSource, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *sqsSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_sqs_source.SqsSourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sqsSource, err := client.RestDo[client.SqsSource](ctx, r.rest, http.MethodGet, sqsSourcePath+"/"+data.Id.ValueString(), nil)
+	if handleReadError(ctx, resp, "SQS Source", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Got SQS Source", map[string]any{
+		"id": sqsSource.IntegrationId,
+	})
+
+	setSqsSource(ctx, &data, sqsSource, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *sqsSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_sqs_source.SqsSourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input := sqsSourceInput(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sqsSource, err := client.RestDo[client.SqsSource](ctx, r.rest, http.MethodPut, sqsSourcePath+"/"+data.Id.ValueString(), input)
+	if handleUpdateError(ctx, resp, "SQS Source", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated SQS Source", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+
+	setSqsSource(ctx, &data, sqsSource, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *sqsSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_sqs_source.SqsSourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RestDelete(ctx, r.rest, sqsSourcePath+"/"+data.Id.ValueString())
+	if handleDeleteError(resp, "SQS Source", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Deleted SQS Source", map[string]any{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *sqsSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func sqsSourceInput(ctx context.Context, data resource_sqs_source.SqsSourceModel, diagnostics *diag.Diagnostics) client.SqsSourceInput {
+	return client.SqsSourceInput{
+		IntegrationLabel:     data.IntegrationLabel.ValueString(),
+		LogTypes:             listToStringSlice(ctx, data.LogTypes, diagnostics),
+		AllowedPrincipalArns: nonNilStrings(listToStringSlice(ctx, data.AllowedPrincipalArns, diagnostics)),
+		AllowedSourceArns:    nonNilStrings(listToStringSlice(ctx, data.AllowedSourceArns, diagnostics)),
+	}
+}
+
+// setSqsSource maps the API response to state. The source has no secrets, so every
+// field comes from the API.
+func setSqsSource(ctx context.Context, data *resource_sqs_source.SqsSourceModel, sqsSource client.SqsSource, diagnostics *diag.Diagnostics) {
+	data.Id = types.StringValue(sqsSource.IntegrationId)
+	data.IntegrationLabel = types.StringValue(sqsSource.IntegrationLabel)
+	data.LogTypes = stringSliceToList(ctx, sqsSource.LogTypes, diagnostics)
+	data.AllowedPrincipalArns = stringSliceToList(ctx, nonNilStrings(sqsSource.AllowedPrincipalArns), diagnostics)
+	data.AllowedSourceArns = stringSliceToList(ctx, nonNilStrings(sqsSource.AllowedSourceArns), diagnostics)
+	data.QueueUrl = types.StringValue(sqsSource.QueueUrl)
+}
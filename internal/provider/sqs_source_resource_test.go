@@ -0,0 +1,132 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestSqsSourceResource covers create with the default empty access lists, import, and
+// an update that opens the queue to a role and an SNS topic. The queue URL is assigned
+// by Panther on create and must survive the update.
+func TestSqsSourceResource(t *testing.T) {
+	label := "test-sqs-" + uuid.NewString()
+	config := func(logTypes, access string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "panther_sqs_source" "test" {
+  integration_label = %q
+  log_types         = %s
+  %s
+}
+`, label, logTypes, access)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`["AWS.CloudTrail"]`, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("panther_sqs_source.test", "id"),
+					resource.TestCheckResourceAttr("panther_sqs_source.test", "log_types.0", "AWS.CloudTrail"),
+					resource.TestCheckResourceAttr("panther_sqs_source.test", "allowed_principal_arns.#", "0"),
+					resource.TestCheckResourceAttr("panther_sqs_source.test", "allowed_source_arns.#", "0"),
+					resource.TestMatchResourceAttr("panther_sqs_source.test", "queue_url", regexp.MustCompile(`^https://sqs\.`)),
+				),
+			},
+			{
+				ResourceName:      "panther_sqs_source.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(`["AWS.CloudTrail", "AWS.VPCFlow"]`, `
+  allowed_principal_arns = ["arn:aws:iam::123456789012:role/log-shipper"]
+  allowed_source_arns    = ["arn:aws:sns:us-east-1:123456789012:app-logs"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_sqs_source.test", "log_types.#", "2"),
+					resource.TestCheckResourceAttr("panther_sqs_source.test", "allowed_principal_arns.0", "arn:aws:iam::123456789012:role/log-shipper"),
+					resource.TestCheckResourceAttr("panther_sqs_source.test", "allowed_source_arns.0", "arn:aws:sns:us-east-1:123456789012:app-logs"),
+					resource.TestMatchResourceAttr("panther_sqs_source.test", "queue_url", regexp.MustCompile(`^https://sqs\.`)),
+				),
+			},
+		},
+	})
+}
+
+// TestSqsSourceResource_PlanTimeValidation covers errors raised before any API call.
+func TestSqsSourceResource_PlanTimeValidation(t *testing.T) {
+	cases := map[string]struct {
+		attributes string
+		err        string
+	}{
+		"principal_not_iam": {
+			attributes: `allowed_principal_arns = ["arn:aws:sns:us-east-1:123456789012:app-logs"]`,
+			err:        `must\s+be\s+an\s+IAM\s+account\s+root,\s+user\s+or\s+role\s+ARN`,
+		},
+		"principal_account_id": {
+			attributes: `allowed_principal_arns = ["123456789012"]`,
+			err:        `must\s+be\s+an\s+IAM\s+account\s+root,\s+user\s+or\s+role\s+ARN`,
+		},
+		"source_not_arn": {
+			attributes: `allowed_source_arns = ["app-logs"]`,
+			err:        `must be an AWS\s+resource\s+ARN`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: providerConfig + fmt.Sprintf(`
+resource "panther_sqs_source" "test" {
+  integration_label = "plan-time-validation"
+  log_types         = ["AWS.CloudTrail"]
+  %s
+}
+`, tc.attributes),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.err),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestSqsSourceResource_UnknownLogType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "panther_sqs_source" "test" {
+  integration_label = "unknown-log-type"
+  log_types         = ["AWS.Cloudtrail"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`(?s)Unknown Log Type.*Did you\s+mean\s+"AWS.CloudTrail"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"terraform-provider-panther/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// emailRegex only checks the shape of an address; Panther verifies it by sending the
+// invitation.
+var emailRegex = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+var (
+	_ resource.Resource                = (*userResource)(nil)
+	_ resource.ResourceWithConfigure   = (*userResource)(nil)
+	_ resource.ResourceWithImportState = (*userResource)(nil)
+)
+
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+type userResource struct {
+	rest *client.RESTClient
+}
+
+type userModel struct {
+	Id         types.String `tfsdk:"id"`
+	Email      types.String `tfsdk:"email"`
+	GivenName  types.String `tfsdk:"given_name"`
+	FamilyName types.String `tfsdk:"family_name"`
+	RoleId     types.String `tfsdk:"role_id"`
+}
+
+func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Panther user. Creating one emails them an invitation to the instance; " +
+			"destroying one removes their access.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The user ID.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"email": schema.StringAttribute{
+				Required:      true,
+				Description:   "The address the invitation is sent to, and the user's login. Changing this forces a new user.",
+				Validators:    []validator.String{stringvalidator.RegexMatches(emailRegex, "must be an email address")},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"given_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The user's first name.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"family_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The user's last name.",
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"role_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the user's `panther_role`.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+		},
+	}
+}
+
+func (r *userResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.rest = restClient(req, resp)
+}
+
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data userModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := client.RestDo[client.User](ctx, r.rest, http.MethodPost, userPath, data.toAPI())
+	if handleCreateError(resp, "User", err) {
+		return
+	}
+	tflog.Debug(ctx, "Created User", map[string]any{"id": user.ID})
+
+	data.set(user)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data userModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := client.RestDo[client.User](ctx, r.rest, http.MethodGet, userPath+"/"+data.Id.ValueString(), nil)
+	if handleReadError(ctx, resp, "User", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Read User", map[string]any{"id": user.ID})
+
+	data.set(user)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data userModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := client.RestDo[client.User](ctx, r.rest, http.MethodPut, userPath+"/"+data.Id.ValueString(), data.toAPI())
+	if handleUpdateError(ctx, resp, "User", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Updated User", map[string]any{"id": data.Id.ValueString()})
+
+	data.set(user)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data userModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.RestDelete(ctx, r.rest, userPath+"/"+data.Id.ValueString())
+	if handleDeleteError(resp, "User", data.Id.ValueString(), err) {
+		return
+	}
+	tflog.Debug(ctx, "Deleted User", map[string]any{"id": data.Id.ValueString()})
+}
+
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m userModel) toAPI() client.UserInput {
+	return client.UserInput{
+		Email:      m.Email.ValueString(),
+		GivenName:  m.GivenName.ValueString(),
+		FamilyName: m.FamilyName.ValueString(),
+		RoleID:     m.RoleId.ValueString(),
+	}
+}
+
+func (m *userModel) set(user client.User) {
+	m.Id = types.StringValue(user.ID)
+	m.Email = types.StringValue(user.Email)
+	m.GivenName = types.StringValue(user.GivenName)
+	m.FamilyName = types.StringValue(user.FamilyName)
+	m.RoleId = types.StringValue(user.RoleID)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestUserResource covers create, import, moving the user to another role, and that
+// a role can't be destroyed while a user is assigned to it (Terraform orders the
+// destroy so the user goes first).
+func TestUserResource(t *testing.T) {
+	suffix := uuid.NewString()
+	config := func(role, givenName string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "panther_role" "analyst" {
+  name        = "test-analyst-%[1]s"
+  permissions = ["AlertRead", "DataAnalyticsRead"]
+}
+
+resource "panther_role" "admin" {
+  name        = "test-admin-%[1]s"
+  permissions = ["AlertModify", "RuleModify", "UserModify"]
+}
+
+resource "panther_user" "test" {
+  email       = "test-%[1]s@example.com"
+  given_name  = %[3]q
+  family_name = "Doe"
+  role_id     = panther_role.%[2]s.id
+}
+`, suffix, role, givenName)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("analyst", "Jane"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("panther_user.test", "id"),
+					resource.TestCheckResourceAttr("panther_user.test", "email", "test-"+suffix+"@example.com"),
+					resource.TestCheckResourceAttrPair("panther_user.test", "role_id", "panther_role.analyst", "id"),
+				),
+			},
+			{
+				ResourceName:      "panther_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config("admin", "Janet"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panther_user.test", "given_name", "Janet"),
+					resource.TestCheckResourceAttrPair("panther_user.test", "role_id", "panther_role.admin", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestUserResource_InvalidEmail(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "panther_user" "test" {
+  email       = "jane.doe"
+  given_name  = "Jane"
+  family_name = "Doe"
+  role_id     = "some-role"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be an email address`),
+			},
+		},
+	})
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	_ basetypes.StringTypable                    = yamlType{}
+	_ basetypes.StringValuableWithSemanticEquals = yamlValue{}
+	_ xattr.ValidateableAttribute                = yamlValue{}
+)
+
+// yamlType is a string attribute type holding a YAML document. Values that parse to the
+// same data are semantically equal, so reordered keys, comments, quoting and indentation
+// don't show up as diffs, and the API re-serializing the document doesn't cause drift.
+// Map key order is irrelevant; sequence order is significant.
+type (
+	yamlType  = semanticStringType[yamlFormat]
+	yamlValue = semanticStringValue[yamlFormat]
+)
+
+type yamlFormat struct{}
+
+func newYAMLValue(s string) yamlValue {
+	return yamlValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+func (yamlFormat) typeName() string {
+	return "yamlType"
+}
+
+func (yamlFormat) decode(s string) (any, error) {
+	var data any
+	err := yaml.Unmarshal([]byte(s), &data)
+	return data, err
+}
+
+func (yamlFormat) invalid(err error) (string, string) {
+	return "Invalid YAML", fmt.Sprintf("The value is not valid YAML: %s", err)
+}
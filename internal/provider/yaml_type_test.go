@@ -0,0 +1,61 @@
+/*
+Copyright 2023 Panther Labs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYAMLValue_SemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	base := newYAMLValue("fields:\n  - name: a\n    type: string\n")
+	cases := map[string]struct {
+		other string
+		equal bool
+	}{
+		"reordered keys":   {"fields:\n  - type: string\n    name: a\n", true},
+		"comments":         {"# note\nfields:\n  - name: a # the a\n    type: string\n", true},
+		"flow style":       {"{fields: [{name: a, type: string}]}", true},
+		"changed value":    {"fields:\n  - name: b\n    type: string\n", false},
+		"reordered fields": {"fields:\n  - name: a\n    type: string\n  - name: b\n    type: string\n", false},
+		"invalid":          {"fields: [", false},
+	}
+	for name, tc := range cases {
+		equal, diags := base.StringSemanticEquals(ctx, newYAMLValue(tc.other))
+		assert.False(t, diags.HasError(), name)
+		assert.Equal(t, tc.equal, equal, name)
+	}
+}
+
+func TestYAMLValue_ValidateAttribute(t *testing.T) {
+	ctx := context.Background()
+	req := xattr.ValidateAttributeRequest{Path: path.Root("spec")}
+
+	var valid xattr.ValidateAttributeResponse
+	newYAMLValue("fields: []").ValidateAttribute(ctx, req, &valid)
+	assert.False(t, valid.Diagnostics.HasError())
+
+	var invalid xattr.ValidateAttributeResponse
+	newYAMLValue("fields: [").ValidateAttribute(ctx, req, &invalid)
+	assert.True(t, invalid.Diagnostics.HasError())
+	assert.Equal(t, "Invalid YAML", invalid.Diagnostics[0].Summary())
+}